@@ -0,0 +1,117 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Readiness is what, if anything, stands between one of my PRs and merging.
+type Readiness string
+
+const (
+	ReadyToMerge     Readiness = "ready"
+	ReadyDraft       Readiness = "draft"
+	ReadyConflict    Readiness = "conflicts"
+	ReadyCIFailing   Readiness = "ci failing"
+	ReadyChanges     Readiness = "changes"
+	ReadyNeedsReview Readiness = "needs review"
+	ReadyCIPending   Readiness = "ci running"
+)
+
+// computeReadiness names the first blocker for merging a PR, in the order
+// I'd have to deal with them.
+func computeReadiness(pr PRNode, me string) Readiness {
+	switch {
+	case pr.IsDraft:
+		return ReadyDraft
+	case pr.Mergeable == "CONFLICTING":
+		return ReadyConflict
+	}
+	status := computeStatus(pr)
+	if status == StatusFail {
+		return ReadyCIFailing
+	}
+	oth := computeOthReview(pr, me)
+	switch {
+	case pr.ReviewDecision == "CHANGES_REQUESTED" || oth == OthChanges:
+		return ReadyChanges
+	case pr.ReviewDecision == "REVIEW_REQUIRED",
+		pr.ReviewDecision == "" && oth != OthApproved && len(pr.ReviewRequests.Nodes) > 0:
+		return ReadyNeedsReview
+	case status == StatusPending:
+		return ReadyCIPending
+	}
+	return ReadyToMerge
+}
+
+// pendingReviewers lists the users and teams (as @org/slug) whose review is
+// still requested.
+func pendingReviewers(pr PRNode) []string {
+	org, _, _ := strings.Cut(pr.Repository.NameWithOwner, "/")
+	var names []string
+	for _, rr := range pr.ReviewRequests.Nodes {
+		switch {
+		case rr.RequestedReviewer.Login != "":
+			names = append(names, rr.RequestedReviewer.Login)
+		case rr.RequestedReviewer.Slug != "":
+			names = append(names, "@"+org+"/"+rr.RequestedReviewer.Slug)
+		}
+	}
+	return names
+}
+
+// changesRequestedBy lists the reviewers whose latest review requests
+// changes, sorted.
+func changesRequestedBy(pr PRNode, me string) []string {
+	var names []string
+	for login, r := range latestOtherReviews(pr, me) {
+		if r.State == "CHANGES_REQUESTED" {
+			names = append(names, login)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// authorWidths are the My PRs view's variable column widths.
+type authorWidths struct {
+	repo    int
+	ready   int
+	pending int
+	changes int
+}
+
+// maxNamesWidth caps the reviewer columns so a long list doesn't push
+// titles off screen.
+const maxNamesWidth = 24
+
+func computeAuthorColumns(items []ClassifiedPR) authorWidths {
+	w := authorWidths{ready: len("merge"), pending: len("waiting on"), changes: len("changes by")}
+	for _, pr := range items {
+		w.repo = max(w.repo, len(pr.RepoName)+1+len(fmt.Sprint(pr.Number)))
+		w.ready = max(w.ready, len(pr.Readiness))
+		w.pending = max(w.pending, len(joinNames(pr.PendingReviewers)))
+		w.changes = max(w.changes, len(joinNames(pr.ChangesRequestedBy)))
+	}
+	w.pending = min(w.pending, maxNamesWidth)
+	w.changes = min(w.changes, maxNamesWidth)
+	return w
+}
+
+// joinNames renders a reviewer column, "·" when empty.
+func joinNames(names []string) string {
+	if len(names) == 0 {
+		return "·"
+	}
+	return strings.Join(names, ",")
+}
+
+// fitNames pads or truncates a reviewer column to width.
+func fitNames(names []string, width int) string {
+	s := joinNames(names)
+	if len(s) > width {
+		s = s[:width-1] + "…"
+	}
+	return fmt.Sprintf("%-*s", width, s)
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func withCheckState(state string) func(*PRNode) {
+	return func(pr *PRNode) {
+		pr.Commits.Nodes[0].Commit.StatusCheckRollup = &struct {
+			State string `json:"state"`
+		}{state}
+	}
+}
+
+func TestComputeReadiness(t *testing.T) {
+	at := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	draft := func(pr *PRNode) { pr.IsDraft = true }
+	conflicting := func(pr *PRNode) { pr.Mergeable = "CONFLICTING" }
+	decision := func(d string) func(*PRNode) {
+		return func(pr *PRNode) { pr.ReviewDecision = d }
+	}
+	cases := []struct {
+		name string
+		pr   PRNode
+		want Readiness
+	}{
+		{"draft beats everything", makePR(draft, conflicting, withCheckState("FAILURE")), ReadyDraft},
+		{"conflicts", makePR(conflicting, withCheckState("SUCCESS")), ReadyConflict},
+		{"ci failing", makePR(withCheckState("ERROR"), withReview("bob", "CHANGES_REQUESTED", at)), ReadyCIFailing},
+		{"changes requested", makePR(withReview("bob", "CHANGES_REQUESTED", at)), ReadyChanges},
+		{"review required", makePR(decision("REVIEW_REQUIRED"), withCheckState("SUCCESS")), ReadyNeedsReview},
+		{"outstanding request", makePR(withReviewRequest("bob", "", false)), ReadyNeedsReview},
+		{"approved, ci running", makePR(decision("APPROVED"), withCheckState("PENDING")), ReadyCIPending},
+		{"approved and green", makePR(decision("APPROVED"), withCheckState("SUCCESS")), ReadyToMerge},
+		{"no requirements", makePR(), ReadyToMerge},
+	}
+	for _, tc := range cases {
+		if got := computeReadiness(tc.pr, "me"); got != tc.want {
+			t.Errorf("%s: got %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestPendingReviewersAndChangesRequestedBy(t *testing.T) {
+	t1 := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+	pr := makePR(
+		withReviewRequest("bob", "", false),
+		withReviewRequest("", "backend", true),
+		withReview("carol", "CHANGES_REQUESTED", t1),
+		withReview("dave", "CHANGES_REQUESTED", t1),
+		withReview("dave", "APPROVED", t2),
+		withReview("alice", "CHANGES_REQUESTED", t2),
+	)
+	if got, want := pendingReviewers(pr), []string{"bob", "@org/backend"}; !slices.Equal(got, want) {
+		t.Errorf("pendingReviewers = %v, want %v", got, want)
+	}
+	if got, want := changesRequestedBy(pr, "me"), []string{"alice", "carol"}; !slices.Equal(got, want) {
+		t.Errorf("changesRequestedBy = %v, want %v", got, want)
+	}
+}
+
+func TestFitNames(t *testing.T) {
+	cases := []struct {
+		names []string
+		width int
+		want  string
+	}{
+		{nil, 5, "·    "},
+		{[]string{"bob"}, 5, "bob  "},
+		{[]string{"alice", "bob"}, 6, "alice…"},
+	}
+	for _, tc := range cases {
+		if got := fitNames(tc.names, tc.width); got != tc.want {
+			t.Errorf("fitNames(%v, %d) = %q, want %q", tc.names, tc.width, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// BotConfig describes a review bot that's asked for a review by mentioning
+// it in a comment, such as the Claude GitHub app. Zero fields use the
+// defaults.
+type BotConfig struct {
+	// Trigger is the mention that asks the bot for a review.
+	Trigger string `json:"trigger,omitempty"` // default "@claude"
+	// Logins are the bot's accounts; their comments and reviews answer a
+	// request.
+	Logins []string `json:"logins,omitempty"` // default claude, claude[bot]
+	// Working marks a bot comment that's still in progress: the bot edits
+	// it once it's done.
+	Working string `json:"working,omitempty"` // default "is working"
+
+	trigger *regexp.Regexp
+}
+
+// BotStatus is where the latest bot review request on a PR stands.
+type BotStatus string
+
+const (
+	BotNone      BotStatus = ""
+	BotRequested BotStatus = "requested"
+	BotWorking   BotStatus = "in progress"
+	BotDone      BotStatus = "done"
+)
+
+// outstanding reports whether the bot was asked and hasn't finished.
+func (s BotStatus) outstanding() bool {
+	return s == BotRequested || s == BotWorking
+}
+
+func (c BotConfig) withDefaults() BotConfig {
+	if c.Trigger == "" {
+		c.Trigger = "@claude"
+	}
+	if len(c.Logins) == 0 {
+		c.Logins = []string{"claude", "claude[bot]"}
+	}
+	if c.Working == "" {
+		c.Working = "is working"
+	}
+	return c
+}
+
+func (c *BotConfig) compile() error {
+	d := c.withDefaults()
+	if strings.TrimSpace(d.Trigger) == "" {
+		return fmt.Errorf("trigger is blank")
+	}
+	// The mention must not run on into a longer name (@claude vs @claudette)
+	re, err := regexp.Compile(`(?i)` + regexp.QuoteMeta(d.Trigger) + `($|[^\w-])`)
+	if err != nil {
+		return err
+	}
+	c.trigger = re
+	return nil
+}
+
+// triggers reports whether a comment body asks the bot for a review.
+func (c BotConfig) triggers(body string) bool {
+	if c.trigger == nil {
+		if err := c.compile(); err != nil {
+			return false
+		}
+	}
+	return c.trigger.MatchString(body)
+}
+
+// isBot reports whether login is one of the bot's accounts. GitHub shows
+// app accounts with or without the [bot] suffix depending on the API.
+func (c BotConfig) isBot(login string) bool {
+	login = strings.TrimSuffix(strings.ToLower(login), "[bot]")
+	for _, l := range c.withDefaults().Logins {
+		if strings.TrimSuffix(strings.ToLower(l), "[bot]") == login {
+			return true
+		}
+	}
+	return false
+}
+
+// botActivity is the bot status found in a PR's history.
+type botActivity struct {
+	Status      BotStatus
+	RequestedBy string
+	RequestedAt time.Time
+	AnsweredAt  time.Time
+}
+
+// computeBotReview finds the latest comment or review asking the bot for a
+// review and whether the bot has answered since. A bot comment still
+// carrying the working marker means it's in progress. Bot activity with no
+// request in sight (say, a review on open) counts as done.
+func computeBotReview(pr PRNode, c BotConfig) botActivity {
+	if c.trigger == nil {
+		_ = c.compile()
+	}
+	working := strings.ToLower(c.withDefaults().Working)
+
+	var a botActivity
+	request := func(who, body string, at time.Time) {
+		if !c.isBot(who) && c.triggers(body) && !at.Before(a.RequestedAt) {
+			a.RequestedBy, a.RequestedAt = who, at
+		}
+	}
+	for _, cm := range pr.Comments.Nodes {
+		request(cm.Author.Login, cm.Body, cm.CreatedAt)
+	}
+	for _, r := range pr.Reviews.Nodes {
+		request(r.Author.Login, r.Body, r.SubmittedAt)
+	}
+
+	// The bot's latest answer since the request
+	var answerBody string
+	answer := func(who, body string, at time.Time) {
+		if c.isBot(who) && !at.Before(a.RequestedAt) && !at.Before(a.AnsweredAt) {
+			a.AnsweredAt, answerBody = at, body
+		}
+	}
+	for _, cm := range pr.Comments.Nodes {
+		answer(cm.Author.Login, cm.Body, cm.CreatedAt)
+	}
+	for _, r := range pr.Reviews.Nodes {
+		answer(r.Author.Login, r.Body, r.SubmittedAt)
+	}
+
+	switch {
+	case a.AnsweredAt.IsZero() && a.RequestedAt.IsZero():
+		a.Status = BotNone
+	case a.AnsweredAt.IsZero():
+		a.Status = BotRequested
+	case strings.Contains(strings.ToLower(answerBody), working):
+		a.Status = BotWorking
+	default:
+		a.Status = BotDone
+	}
+	return a
+}
+
+func explainBot(pr ClassifiedPR, c BotConfig) string {
+	trigger := c.withDefaults().Trigger
+	asked := fmt.Sprintf("%s asked by %s at %s", trigger, pr.BotRequestedBy, fmtTime(pr.BotRequestedAt))
+	switch pr.BotReview {
+	case BotRequested:
+		return asked + "; no answer yet"
+	case BotWorking:
+		return fmt.Sprintf("%s; bot started at %s and is still working", asked, fmtTime(pr.BotAnsweredAt))
+	case BotDone:
+		if pr.BotRequestedAt.IsZero() {
+			return fmt.Sprintf("not asked; bot answered at %s", fmtTime(pr.BotAnsweredAt))
+		}
+		return fmt.Sprintf("%s; bot answered at %s", asked, fmtTime(pr.BotAnsweredAt))
+	}
+	return fmt.Sprintf("%s never asked", trigger)
+}
+
+// setBot fills in the bot review fields.
+func (c *ClassifiedPR) setBot(pr PRNode, bot BotConfig) {
+	a := computeBotReview(pr, bot)
+	c.BotReview, c.BotRequestedBy = a.Status, a.RequestedBy
+	c.BotRequestedAt, c.BotAnsweredAt = a.RequestedAt, a.AnsweredAt
+}
+
+// botSummary is the marker shown after a PR's title.
+func botSummary(pr ClassifiedPR) string {
+	if pr.BotReview == BotNone {
+		return ""
+	}
+	return "[bot review " + string(pr.BotReview) + "]"
+}
+
+// botWarning warns before posting a comment that asks the bot again while
+// an earlier request is still outstanding.
+func botWarning(pr ClassifiedPR, body string, bot BotConfig) string {
+	if !pr.BotReview.outstanding() || !bot.triggers(body) {
+		return ""
+	}
+	when := "just now"
+	if age := formatAge(pr.BotRequestedAt); age != "now" {
+		when = age + " ago"
+	}
+	return fmt.Sprintf("⚠ %s already asked by %s %s, bot review %s. ",
+		bot.withDefaults().Trigger, pr.BotRequestedBy, when, pr.BotReview)
+}
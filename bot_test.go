@@ -0,0 +1,92 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func withBotReview(login, body string, at time.Time) func(*PRNode) {
+	return func(pr *PRNode) {
+		r := ReviewNode{State: "COMMENTED", Body: body, SubmittedAt: at}
+		r.Author.Login = login
+		pr.Reviews.Nodes = append(pr.Reviews.Nodes, r)
+	}
+}
+
+func TestComputeBotReview(t *testing.T) {
+	t1 := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)
+	t3 := time.Date(2025, 1, 4, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name string
+		pr   PRNode
+		want BotStatus
+		by   string
+	}{
+		{"never asked", makePR(withCommentBody("alice", "looks good", t1)), BotNone, ""},
+		{"longer name is not a trigger", makePR(withCommentBody("alice", "cc @claudette", t1)), BotNone, ""},
+		{"asked", makePR(withCommentBody("alice", "@claude please review this PR", t1)), BotRequested, "alice"},
+		{"asked in a review", makePR(withBotReview("bob", "@Claude can you check the locking?", t1)), BotRequested, "bob"},
+		{"working", makePR(
+			withCommentBody("alice", "@claude please review this PR", t1),
+			withCommentBody("claude", "**Claude is working…**", t2),
+		), BotWorking, "alice"},
+		{"done", makePR(
+			withCommentBody("alice", "@claude please review this PR", t1),
+			withCommentBody("claude[bot]", "**Claude finished @alice's task**", t2),
+		), BotDone, "alice"},
+		{"done by review", makePR(
+			withCommentBody("alice", "@claude please review this PR", t1),
+			withBotReview("claude", "Looks fine overall", t2),
+		), BotDone, "alice"},
+		{"asked again after an answer", makePR(
+			withCommentBody("alice", "@claude please review this PR", t1),
+			withCommentBody("claude", "Review done", t2),
+			withCommentBody("bob", "@claude review again please", t3),
+		), BotRequested, "bob"},
+		{"the bot quoting the trigger is no request", makePR(
+			withCommentBody("claude", "Reply with @claude to ask again", t1),
+		), BotDone, ""},
+	}
+	for _, tc := range cases {
+		got := computeBotReview(tc.pr, BotConfig{})
+		if got.Status != tc.want || got.RequestedBy != tc.by {
+			t.Errorf("%s: got %q by %q, want %q by %q", tc.name, got.Status, got.RequestedBy, tc.want, tc.by)
+		}
+	}
+}
+
+func TestComputeBotReview_Custom(t *testing.T) {
+	t1 := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)
+	bot := BotConfig{Trigger: "/review", Logins: []string{"review-bot"}, Working: "in progress"}
+	if err := bot.compile(); err != nil {
+		t.Fatal(err)
+	}
+	pr := makePR(
+		withCommentBody("alice", "/review", t1),
+		withCommentBody("review-bot[bot]", "Review in progress", t2),
+	)
+	if got := computeBotReview(pr, bot); got.Status != BotWorking {
+		t.Errorf("expected in progress, got %q", got.Status)
+	}
+	if got := computeBotReview(pr, BotConfig{}); got.Status != BotNone {
+		t.Errorf("expected default bot to ignore a custom trigger, got %q", got.Status)
+	}
+}
+
+func TestBotWarning(t *testing.T) {
+	pr := ClassifiedPR{BotReview: BotRequested, BotRequestedBy: "alice", BotRequestedAt: time.Now().Add(-3 * time.Hour)}
+	got := botWarning(pr, "@claude please review this PR", BotConfig{})
+	if !strings.Contains(got, "already asked by alice 3h ago") {
+		t.Errorf("unexpected warning %q", got)
+	}
+	if got := botWarning(pr, "friendly ping", BotConfig{}); got != "" {
+		t.Errorf("expected no warning for a comment that doesn't ask the bot, got %q", got)
+	}
+	pr.BotReview = BotDone
+	if got := botWarning(pr, "@claude please review this PR", BotConfig{}); got != "" {
+		t.Errorf("expected no warning once the bot is done, got %q", got)
+	}
+}
@@ -0,0 +1,333 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// bulkKind is an action that can run on every marked PR at once.
+type bulkKind int
+
+const (
+	bulkDismiss bulkKind = iota
+	bulkOpen
+	bulkComment
+	bulkLabel
+)
+
+// bulkAction is an action on the marked PRs, awaiting confirmation.
+type bulkAction struct {
+	kind   bulkKind
+	prs    []ClassifiedPR
+	bodies []string // rendered comment per PR, for bulkComment
+	name   string   // template name or label
+}
+
+// confirmKey is the key that confirms the action, pressed a second time.
+func (a bulkAction) confirmKey() string {
+	switch a.kind {
+	case bulkDismiss:
+		return "d"
+	case bulkOpen:
+		return "enter"
+	case bulkComment:
+		return "c"
+	case bulkLabel:
+		return "L"
+	}
+	return ""
+}
+
+func (a bulkAction) done() string {
+	switch a.kind {
+	case bulkDismiss:
+		return "Dismissed"
+	case bulkOpen:
+		return "Opened"
+	case bulkComment:
+		return "Posted " + a.name + " on"
+	}
+	return fmt.Sprintf("Added label %q to", a.name)
+}
+
+func countPRs(n int) string {
+	if n == 1 {
+		return "1 PR"
+	}
+	return fmt.Sprintf("%d PRs", n)
+}
+
+type bulkFailure struct {
+	pr  ClassifiedPR
+	err error
+}
+
+type bulkDoneMsg struct {
+	action bulkAction
+	done   []int // indexes into action.prs
+	failed []bulkFailure
+}
+
+// bulkCmd runs an action on each PR in turn, carrying on past failures.
+func bulkCmd(a bulkAction) tea.Cmd {
+	return func() tea.Msg {
+		res := bulkDoneMsg{action: a}
+		for i, pr := range a.prs {
+			var err error
+			switch a.kind {
+			case bulkOpen:
+				err = openBrowser(pr.URL)
+			case bulkComment:
+				err = postComment(pr.RepoFullName, pr.Number, a.bodies[i])
+			case bulkLabel:
+				err = addLabels(pr.RepoFullName, pr.Number, []string{a.name})
+			}
+			if err != nil {
+				res.failed = append(res.failed, bulkFailure{pr, err})
+			} else {
+				res.done = append(res.done, i)
+			}
+		}
+		return res
+	}
+}
+
+// summary describes how the action went, for the status bar.
+func (r bulkDoneMsg) summary() string {
+	total := len(r.action.prs)
+	if len(r.failed) == 0 {
+		return fmt.Sprintf("%s %s", r.action.done(), countPRs(total))
+	}
+	names := make([]string, len(r.failed))
+	for i, f := range r.failed {
+		names[i] = fmt.Sprintf("%s#%d", f.pr.RepoName, f.pr.Number)
+	}
+	return fmt.Sprintf("%s %d of %s; failed: %s (%v)",
+		r.action.done(), len(r.done), countPRs(total), strings.Join(names, ", "), r.failed[0].err)
+}
+
+// recordLabel adds a label I just added to the raw PR, as the next fetch
+// would, so rules and tabs matching on it apply straight away.
+func recordLabel(prs []PRNode, url, label string) {
+	for i := range prs {
+		pr := &prs[i]
+		if pr.URL != url || slices.Contains(labelNames(*pr), label) {
+			continue
+		}
+		pr.Labels.Nodes = append(pr.Labels.Nodes, struct {
+			Name string `json:"name"`
+		}{label})
+	}
+}
+
+// markedItems returns the visible PRs that are marked, in list order.
+func (m model) markedItems() []ClassifiedPR {
+	var out []ClassifiedPR
+	for _, pr := range m.visibleItems() {
+		if m.marked[pr.URL] {
+			out = append(out, pr)
+		}
+	}
+	return out
+}
+
+// toggleMark marks or unmarks the selected PR and moves to the next one.
+func (m *model) toggleMark() {
+	pr, ok := m.selectedPR()
+	if !ok {
+		return
+	}
+	if m.marked[pr.URL] {
+		delete(m.marked, pr.URL)
+	} else {
+		m.marked[pr.URL] = true
+	}
+	if m.cursor < len(m.rows())-1 {
+		m.cursor++
+	}
+}
+
+// markRange starts a range at the cursor, or marks every PR between the
+// start and the cursor.
+func (m *model) markRange() {
+	pr, ok := m.selectedPR()
+	if !ok {
+		return
+	}
+	if m.rangeFrom < 0 {
+		m.rangeFrom = m.cursor
+		m.statusMsg = fmt.Sprintf("Marking from %s#%d: move and press V again", pr.RepoName, pr.Number)
+		return
+	}
+	rows := m.rows()
+	from, to := min(m.rangeFrom, m.cursor), min(max(m.rangeFrom, m.cursor), len(rows)-1)
+	n := 0
+	for _, r := range rows[from : to+1] {
+		if r.header == "" {
+			m.marked[r.pr.URL] = true
+			n++
+		}
+	}
+	m.rangeFrom = -1
+	m.statusMsg = fmt.Sprintf("Marked %s, %d in all", countPRs(n), len(m.markedItems()))
+}
+
+// markAll marks every visible PR, or unmarks them if they all are.
+func (m *model) markAll() {
+	vis := m.visibleItems()
+	if len(vis) > 0 && len(m.markedItems()) == len(vis) {
+		for _, pr := range vis {
+			delete(m.marked, pr.URL)
+		}
+		m.statusMsg = "Unmarked all"
+		return
+	}
+	for _, pr := range vis {
+		m.marked[pr.URL] = true
+	}
+	m.statusMsg = fmt.Sprintf("Marked all %s", countPRs(len(vis)))
+}
+
+func (m *model) clearMarks() {
+	clear(m.marked)
+	m.rangeFrom = -1
+}
+
+// confirmBulk asks for confirmation before running an action on prs.
+func (m *model) confirmBulk(a bulkAction) {
+	m.confirmingBulk = &a
+	n := countPRs(len(a.prs))
+	key := a.confirmKey()
+	switch a.kind {
+	case bulkDismiss:
+		m.statusMsg = fmt.Sprintf("Dismiss %s? Press %s to confirm", n, key)
+	case bulkOpen:
+		m.statusMsg = fmt.Sprintf("Open %s in the browser? Press %s to confirm", n, key)
+	case bulkComment:
+		var warn string
+		asked := 0
+		for i, pr := range a.prs {
+			if botWarning(pr, a.bodies[i], m.config.Bot) != "" {
+				asked++
+			}
+		}
+		if asked > 0 {
+			warn = fmt.Sprintf("⚠ %s already asked on %s. ", m.config.Bot.withDefaults().Trigger, countPRs(asked))
+		}
+		m.statusMsg = fmt.Sprintf("%sPost %s on %s? Press %s to confirm", warn, a.name, n, key)
+	case bulkLabel:
+		m.statusMsg = fmt.Sprintf("Label %s %q? Press %s to confirm", n, a.name, key)
+	}
+}
+
+// bulkComment renders template t for each marked PR and asks to post it.
+// Templates aren't opened in the editor in bulk.
+func (m *model) bulkComment(t CommentTemplate, prs []ClassifiedPR) {
+	a := bulkAction{kind: bulkComment, prs: prs, name: t.Name}
+	for _, pr := range prs {
+		body, err := t.render(pr)
+		if err != nil {
+			m.statusMsg = fmt.Sprintf("Template %q on %s#%d: %v", t.Name, pr.RepoName, pr.Number, err)
+			return
+		}
+		a.bodies = append(a.bodies, body)
+	}
+	m.confirmBulk(a)
+}
+
+// runBulk runs a confirmed action. Dismissals are local; the rest run in
+// the background and report back with bulkDoneMsg.
+func (m *model) runBulk(a bulkAction) tea.Cmd {
+	m.clearMarks()
+	if a.kind == bulkDismiss {
+		for _, pr := range a.prs {
+			m.dismissals.dismissPR(pr, m.config.Dismiss, false)
+		}
+		if vis := m.rows(); m.cursor >= len(vis) {
+			m.cursor = max(len(vis)-1, 0)
+		}
+		m.statusMsg = bulkDoneMsg{action: a}.summary()
+		return nil
+	}
+	if a.kind == bulkOpen {
+		for _, pr := range a.prs {
+			m.seen.markSeen(pr)
+		}
+	}
+	m.statusMsg = fmt.Sprintf("Working on %s...", countPRs(len(a.prs)))
+	return bulkCmd(a)
+}
+
+// bulkDone records what a background action changed and summarizes it.
+func (m *model) bulkDone(msg bulkDoneMsg) {
+	a := msg.action
+	var url string
+	if pr, ok := m.selectedPR(); ok {
+		url = pr.URL
+	}
+	switch a.kind {
+	case bulkComment:
+		for _, i := range msg.done {
+			recordComment(m.rawPRs, m.me, a.prs[i].RepoFullName, a.prs[i].Number, a.bodies[i])
+		}
+	case bulkLabel:
+		for _, i := range msg.done {
+			recordLabel(m.rawPRs, a.prs[i].URL, a.name)
+		}
+	}
+	if len(msg.done) > 0 && (a.kind == bulkComment || a.kind == bulkLabel) {
+		m.reclassify()
+		m.selectURL(url)
+	}
+	m.statusMsg = msg.summary()
+}
+
+// bulkTargets is what L acts on: the marked PRs, else the selected one.
+func (m model) bulkTargets() []ClassifiedPR {
+	if prs := m.markedItems(); len(prs) > 0 {
+		return prs
+	}
+	if pr, ok := m.selectedPR(); ok {
+		return []ClassifiedPR{pr}
+	}
+	return nil
+}
+
+// updateLabel handles typing a label after L: enter asks to add it to
+// the targets, esc cancels.
+func (m model) updateLabel(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.labeling = false
+		m.statusMsg = "Cancelled"
+	case tea.KeyEnter:
+		m.labeling = false
+		label := strings.TrimSpace(m.labelInput)
+		prs := m.bulkTargets()
+		if label == "" || len(prs) == 0 {
+			m.statusMsg = "Cancelled"
+			return m, nil
+		}
+		m.confirmBulk(bulkAction{kind: bulkLabel, prs: prs, name: label})
+	case tea.KeyBackspace:
+		if r := []rune(m.labelInput); len(r) > 0 {
+			m.labelInput = string(r[:len(r)-1])
+		}
+	case tea.KeySpace:
+		m.labelInput += " "
+	case tea.KeyRunes:
+		m.labelInput += string(msg.Runes)
+	}
+	return m, nil
+}
+
+// markPrefix flags a marked PR before its title.
+func (m model) markPrefix(pr ClassifiedPR) string {
+	if m.marked[pr.URL] {
+		return "● "
+	}
+	return ""
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestBulkSummary(t *testing.T) {
+	prs := []ClassifiedPR{{RepoName: "api", Number: 1}, {RepoName: "web", Number: 2}, {RepoName: "web", Number: 3}}
+	a := bulkAction{kind: bulkComment, prs: prs, name: "@claude"}
+	if got, want := (bulkDoneMsg{action: a, done: []int{0, 1, 2}}).summary(), "Posted @claude on 3 PRs"; got != want {
+		t.Errorf("summary = %q, want %q", got, want)
+	}
+	r := bulkDoneMsg{action: a, done: []int{0}, failed: []bulkFailure{{prs[1], errors.New("403")}, {prs[2], errors.New("404")}}}
+	if got, want := r.summary(), "Posted @claude on 1 of 3 PRs; failed: web#2, web#3 (403)"; got != want {
+		t.Errorf("summary = %q, want %q", got, want)
+	}
+	a = bulkAction{kind: bulkLabel, prs: prs[:1], name: "deps"}
+	if got, want := (bulkDoneMsg{action: a, done: []int{0}}).summary(), `Added label "deps" to 1 PR`; got != want {
+		t.Errorf("summary = %q, want %q", got, want)
+	}
+}
+
+func TestRecordLabel(t *testing.T) {
+	prs := []PRNode{makePR(withURL("https://github.com/org/repo/pull/1")), makePR(withURL("https://github.com/org/repo/pull/2"))}
+	recordLabel(prs, prs[0].URL, "deps")
+	recordLabel(prs, prs[0].URL, "deps")
+	if got := labelNames(prs[0]); !slices.Equal(got, []string{"deps"}) {
+		t.Errorf("labels = %v, want [deps]", got)
+	}
+	if got := labelNames(prs[1]); len(got) != 0 {
+		t.Errorf("expected other PR untouched, got %v", got)
+	}
+}
@@ -0,0 +1,254 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Calendar measures PR age in working time. The zero value (no calendar
+// configured) measures wall-clock time.
+type Calendar struct {
+	Weekdays []string `json:"weekdays,omitempty"` // default mon–fri
+	Hours    string   `json:"hours,omitempty"`    // "09:00-17:00" (default)
+	Timezone string   `json:"timezone,omitempty"` // IANA name; default local
+	Holidays string   `json:"holidays,omitempty"` // file of YYYY-MM-DD dates
+
+	on         bool
+	days       [7]bool
+	start, end int // minutes after midnight
+	loc        *time.Location
+	holidays   map[int64]bool // by civilDay
+}
+
+// ageUnits sets how long a "day", "week", etc. is when formatting an age.
+type ageUnits struct {
+	day, week, month, year time.Duration
+}
+
+var wallUnits = ageUnits{
+	day:   24 * time.Hour,
+	week:  7 * 24 * time.Hour,
+	month: 30 * 24 * time.Hour,
+	year:  365 * 24 * time.Hour,
+}
+
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q (want HH:MM)", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func parseHolidays(data []byte) (map[int64]bool, error) {
+	holidays := make(map[int64]bool)
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for n := 1; sc.Scan(); n++ {
+		line, _, _ := strings.Cut(sc.Text(), "#")
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		d, err := time.Parse("2006-01-02", line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid date %q (want YYYY-MM-DD)", n, line)
+		}
+		holidays[civilDay(d)] = true
+	}
+	return holidays, sc.Err()
+}
+
+func expandHome(path string) string {
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, rest)
+		}
+	}
+	return path
+}
+
+func (c *Calendar) compile() error {
+	c.on = c.Weekdays != nil || c.Hours != "" || c.Timezone != "" || c.Holidays != ""
+	if !c.on {
+		return nil
+	}
+
+	weekdays := c.Weekdays
+	if weekdays == nil {
+		weekdays = []string{"mon", "tue", "wed", "thu", "fri"}
+	} else if len(weekdays) == 0 {
+		// With no working days, no working time would ever pass
+		return fmt.Errorf("weekdays is empty: list at least one working day")
+	}
+	for _, name := range weekdays {
+		key := strings.ToLower(strings.TrimSpace(name))
+		if len(key) > 3 {
+			key = key[:3]
+		}
+		wd, ok := weekdayNames[key]
+		if !ok {
+			return fmt.Errorf("invalid weekday %q", name)
+		}
+		c.days[wd] = true
+	}
+
+	hours := c.Hours
+	if hours == "" {
+		hours = "09:00-17:00"
+	}
+	from, to, ok := strings.Cut(hours, "-")
+	if !ok {
+		return fmt.Errorf("invalid hours %q (want HH:MM-HH:MM)", hours)
+	}
+	var err error
+	if c.start, err = parseClock(from); err != nil {
+		return err
+	}
+	if c.end, err = parseClock(to); err != nil {
+		return err
+	}
+	if c.end <= c.start {
+		return fmt.Errorf("invalid hours %q: end must be after start", hours)
+	}
+
+	c.loc = time.Local
+	if c.Timezone != "" {
+		if c.loc, err = time.LoadLocation(c.Timezone); err != nil {
+			return fmt.Errorf("invalid timezone %q: %w", c.Timezone, err)
+		}
+	}
+
+	if c.Holidays != "" {
+		data, err := os.ReadFile(expandHome(c.Holidays))
+		if err != nil {
+			return fmt.Errorf("reading holidays: %w", err)
+		}
+		if c.holidays, err = parseHolidays(data); err != nil {
+			return fmt.Errorf("holidays %s: %w", c.Holidays, err)
+		}
+	}
+	return nil
+}
+
+// civilDay numbers t's calendar date, in t's location, as days since
+// 1970-01-01.
+func civilDay(t time.Time) int64 {
+	y, mo, d := t.Date()
+	return time.Date(y, mo, d, 0, 0, 0, 0, time.UTC).Unix() / 86400
+}
+
+// civilWeekday is the weekday of a civilDay; 1970-01-01 was a Thursday.
+func civilWeekday(day int64) time.Weekday {
+	return time.Weekday((day%7 + 7 + int64(time.Thursday)) % 7)
+}
+
+func (c Calendar) isWorkday(day int64) bool {
+	return c.days[civilWeekday(day)] && !c.holidays[day]
+}
+
+// workedOn is the working time on one day that falls between from and to.
+func (c Calendar) workedOn(day int64, from, to time.Time) time.Duration {
+	if !c.isWorkday(day) {
+		return 0
+	}
+	y, mo, d := time.Unix(day*86400, 0).UTC().Date()
+	ws := time.Date(y, mo, d, c.start/60, c.start%60, 0, 0, c.loc)
+	we := time.Date(y, mo, d, c.end/60, c.end%60, 0, 0, c.loc)
+	if ws.Before(from) {
+		ws = from
+	}
+	if we.After(to) {
+		we = to
+	}
+	if we.After(ws) {
+		return we.Sub(ws)
+	}
+	return 0
+}
+
+// workdays counts the working days from first to last, inclusive: whole
+// weeks by arithmetic, then the days left over, less holidays in range.
+func (c Calendar) workdays(first, last int64) int64 {
+	if last < first {
+		return 0
+	}
+	perWeek := int64(0)
+	for _, on := range c.days {
+		if on {
+			perWeek++
+		}
+	}
+	n := last - first + 1
+	count := n / 7 * perWeek
+	for day := first + n/7*7; day <= last; day++ {
+		if c.days[civilWeekday(day)] {
+			count++
+		}
+	}
+	for day := range c.holidays {
+		if day >= first && day <= last && c.days[civilWeekday(day)] {
+			count--
+		}
+	}
+	return count
+}
+
+// working returns the working time between from and to: the part of the
+// interval that falls within working hours on a working, non-holiday day.
+// Only the first and last days are clipped to the interval; the days in
+// between count whole. Without a calendar it is plain elapsed time.
+func (c Calendar) working(from, to time.Time) time.Duration {
+	if !c.on {
+		return to.Sub(from)
+	}
+	if !to.After(from) {
+		return 0
+	}
+	from, to = from.In(c.loc), to.In(c.loc)
+	first, last := civilDay(from), civilDay(to)
+	if first == last {
+		return c.workedOn(first, from, to)
+	}
+	day := time.Duration(c.end-c.start) * time.Minute
+	return c.workedOn(first, from, to) +
+		time.Duration(c.workdays(first+1, last-1))*day +
+		c.workedOn(last, from, to)
+}
+
+// since is the working time elapsed from t until now.
+func (c Calendar) since(t time.Time) time.Duration {
+	return c.working(t, time.Now())
+}
+
+func (c Calendar) units() ageUnits {
+	if !c.on {
+		return wallUnits
+	}
+	workdays := 0
+	for _, on := range c.days {
+		if on {
+			workdays++
+		}
+	}
+	day := time.Duration(c.end-c.start) * time.Minute
+	week := day * time.Duration(workdays)
+	return ageUnits{day: day, week: week, month: week * 30 / 7, year: week * 365 / 7}
+}
+
+// formatAge formats the working time since t, in working days and weeks.
+func (c Calendar) formatAge(t time.Time) string {
+	if t.IsZero() {
+		return " -"
+	}
+	return formatDuration(c.since(t), c.units())
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func mustCalendar(t *testing.T, c Calendar) Calendar {
+	t.Helper()
+	if err := c.compile(); err != nil {
+		t.Fatalf("compile: %v", err)
+	}
+	return c
+}
+
+func TestCalendarWorking(t *testing.T) {
+	cal := mustCalendar(t, Calendar{Timezone: "UTC"})
+	at := func(day, hour int) time.Time {
+		// 2024-03-04 is a Monday
+		return time.Date(2024, 3, day, hour, 0, 0, 0, time.UTC)
+	}
+	cases := []struct {
+		name     string
+		from, to time.Time
+		want     time.Duration
+	}{
+		{"within a day", at(4, 10), at(4, 15), 5 * time.Hour},
+		{"before and after hours", at(4, 6), at(4, 20), 8 * time.Hour},
+		{"overnight", at(4, 16), at(5, 10), 2 * time.Hour},
+		{"friday evening to monday morning", at(8, 18), at(11, 9), 0},
+		{"friday afternoon to monday noon", at(8, 15), at(11, 12), 5 * time.Hour},
+		{"full week", at(4, 0), at(11, 0), 40 * time.Hour},
+		{"reversed", at(4, 15), at(4, 10), 0},
+	}
+	for _, tc := range cases {
+		if got := cal.working(tc.from, tc.to); got != tc.want {
+			t.Errorf("%s: working = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestCalendarWorking_Unset(t *testing.T) {
+	cal := mustCalendar(t, Calendar{})
+	from := time.Date(2024, 3, 8, 18, 0, 0, 0, time.UTC)
+	if got := cal.working(from, from.Add(63*time.Hour)); got != 63*time.Hour {
+		t.Errorf("without a calendar expected wall-clock 63h, got %v", got)
+	}
+}
+
+func TestCalendarWorking_Custom(t *testing.T) {
+	cal := mustCalendar(t, Calendar{
+		Weekdays: []string{"Sunday", "mon", "tue", "wed", "thu"},
+		Hours:    "08:30-12:30",
+		Timezone: "Asia/Jerusalem",
+	})
+	loc, _ := time.LoadLocation("Asia/Jerusalem")
+	// Thursday 2024-03-07 noon to Sunday 2024-03-10 09:30 local
+	from := time.Date(2024, 3, 7, 12, 0, 0, 0, loc)
+	to := time.Date(2024, 3, 10, 9, 30, 0, 0, loc)
+	if got, want := cal.working(from.UTC(), to.UTC()), 90*time.Minute; got != want {
+		t.Errorf("working = %v, want %v", got, want)
+	}
+}
+
+func TestCalendarHolidays(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "holidays.txt")
+	data := "# public holidays\n2024-03-05  # tuesday\n\n"
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cal := mustCalendar(t, Calendar{Timezone: "UTC", Holidays: path})
+	from := time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 7, 9, 0, 0, 0, time.UTC)
+	if got, want := cal.working(from, to), 16*time.Hour; got != want {
+		t.Errorf("working = %v, want %v", got, want)
+	}
+}
+
+// walkWorking measures working time one day at a time, as a reference.
+func walkWorking(c Calendar, from, to time.Time) time.Duration {
+	var total time.Duration
+	for day := civilDay(from.In(c.loc)); day <= civilDay(to.In(c.loc)); day++ {
+		total += c.workedOn(day, from, to)
+	}
+	return total
+}
+
+func TestCalendarWorking_MatchesDayByDay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "holidays.txt")
+	if err := os.WriteFile(path, []byte("2024-03-05\n2024-03-16\n2024-04-01\n2024-12-25\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cal := mustCalendar(t, Calendar{Weekdays: []string{"mon", "tue", "wed", "thu", "fri", "sat"}, Hours: "08:30-17:15", Timezone: "Europe/Berlin", Holidays: path})
+	start := time.Date(2024, 3, 1, 7, 45, 0, 0, time.UTC)
+	for i := range 200 {
+		from := start.Add(time.Duration(i*i*37) * time.Minute)
+		to := from.Add(time.Duration(i*53+i*i*i) * time.Minute)
+		if got, want := cal.working(from, to), walkWorking(cal, from, to); got != want {
+			t.Fatalf("working(%v, %v) = %v, day by day %v", from, to, got, want)
+		}
+	}
+}
+
+func TestCalendarCompile_Invalid(t *testing.T) {
+	cases := []Calendar{
+		{Weekdays: []string{"funday"}},
+		{Weekdays: []string{}},
+		{Hours: "9-5"},
+		{Hours: "17:00-09:00"},
+		{Timezone: "Mars/Olympus"},
+		{Holidays: filepath.Join(t.TempDir(), "missing.txt")},
+	}
+	for _, c := range cases {
+		if err := c.compile(); err == nil {
+			t.Errorf("expected error for %+v", c)
+		}
+	}
+}
+
+func TestParseHolidays_Invalid(t *testing.T) {
+	if _, err := parseHolidays([]byte("2024-03-05\nnext tuesday\n")); err == nil {
+		t.Fatal("expected error for invalid date")
+	}
+}
+
+func TestFormatDuration_WorkingUnits(t *testing.T) {
+	u := mustCalendar(t, Calendar{}).units()
+	if u != wallUnits {
+		t.Errorf("expected wall-clock units without a calendar, got %+v", u)
+	}
+	u = mustCalendar(t, Calendar{Timezone: "UTC"}).units()
+	cases := []struct {
+		dur  time.Duration
+		want string
+	}{
+		{30 * time.Minute, "now"},
+		{5 * time.Hour, "5h"},
+		{9 * time.Hour, "1d"},
+		{24 * time.Hour, "3d"},
+		{80 * time.Hour, "2w"},
+		{200 * time.Hour, "1m"},
+	}
+	for _, tc := range cases {
+		if got := formatDuration(tc.dur, u); got != tc.want {
+			t.Errorf("formatDuration(%v) = %q, want %q", tc.dur, got, tc.want)
+		}
+	}
+}
@@ -1,7 +1,10 @@
 package main
 
 import (
+	"fmt"
+	"regexp"
 	"sort"
+	"strings"
 	"time"
 )
 
@@ -15,6 +18,7 @@ const (
 	MyApprovedStale  MyReviewIndicator = "approved_stale"
 	MyChangesStale   MyReviewIndicator = "changes_stale"
 	MyCommentedStale MyReviewIndicator = "commented_stale"
+	MyPending        MyReviewIndicator = "pending"
 )
 
 type OthReviewIndicator string
@@ -34,6 +38,7 @@ const (
 	ActMine       ActivityIndicator = "mine"
 	ActOthersStale ActivityIndicator = "others_stale"
 	ActMineStale   ActivityIndicator = "mine_stale"
+	ActMentioned   ActivityIndicator = "mentioned"
 )
 
 type StatusIndicator string
@@ -46,13 +51,39 @@ const (
 	StatusConflict StatusIndicator = "conflict"
 )
 
+// RequestKind records how a review was requested from me.
+type RequestKind string
+
+const (
+	RequestNone          RequestKind = "none"
+	RequestDirect        RequestKind = "direct"
+	RequestTeam          RequestKind = "team"
+	RequestCodeOwnerTeam RequestKind = "codeowner_team"
+)
+
 type SortMode string
 
 const (
 	SortPriority SortMode = "priority"
 	SortDate     SortMode = "date"
+	// SortSmall puts the smallest diffs first; SortQuickWins puts small
+	// PRs that are ready for my review first.
+	SortSmall     SortMode = "small"
+	SortQuickWins SortMode = "quick-wins"
 )
 
+// sortModes lists the sort modes in the order the TUI cycles through them.
+var sortModes = []SortMode{SortPriority, SortDate, SortSmall, SortQuickWins}
+
+func parseSortMode(s string) (SortMode, error) {
+	for _, m := range sortModes {
+		if string(m) == s {
+			return m, nil
+		}
+	}
+	return "", fmt.Errorf("unknown sort %q (want priority, date, small or quick-wins)", s)
+}
+
 type ClassifiedPR struct {
 	MyReview     MyReviewIndicator
 	OthReview    OthReviewIndicator
@@ -61,6 +92,90 @@ type ClassifiedPR struct {
 	IsDraft     bool
 	IsAuthor    bool
 	IsCodeOwner bool
+	RequestedVia RequestKind
+	// TeamSatisfiedBy names a teammate who already reviewed on behalf of
+	// every team the review was requested from. Empty when the request
+	// still needs me.
+	TeamSatisfiedBy string
+	// RequestedTeams are the teams (org/slug) the review was requested
+	// from: mine in the review list, all of them for my own PRs.
+	RequestedTeams []string
+	// UnresolvedThreads counts open code review threads; ThreadsAwaitingMe
+	// is the subset I took part in where someone else replied after me.
+	UnresolvedThreads int
+	ThreadsAwaitingMe int
+	Labels       []string
+	// Size is the t-shirt size of the diff: Additions + Deletions lines
+	// across ChangedFiles files.
+	Size         PRSize
+	Additions    int
+	Deletions    int
+	ChangedFiles int
+	// BaseRef and HeadRef are the PR's branches; ForkHead is set when
+	// HeadRef is a fork's branch. A PR whose BaseRef is another listed PR's
+	// HeadRef is stacked on it: StackRoot is the URL of the stack's bottom
+	// PR (empty when not stacked), StackParent names the PR below this one,
+	// and StackReviewed of StackSize members are reviewed.
+	BaseRef       string
+	HeadRef       string
+	ForkHead      bool
+	StackRoot     string
+	StackParent   string
+	StackDepth    int
+	StackSize     int
+	StackReviewed int
+	// CommitCount, CommentCount and ReviewCount are activity totals;
+	// Unseen summarizes what changed since I last saw the PR.
+	CommitCount  int
+	CommentCount int
+	ReviewCount  int
+	Unseen       string
+	// PendingReviewers, ChangesRequestedBy and Readiness back the My PRs
+	// view; they're only set by classifyAllAuthor.
+	PendingReviewers   []string
+	ChangesRequestedBy []string
+	Readiness          Readiness
+	// Involvement lists every way I'm involved in the PR, in the order of
+	// the Inv constants.
+	Involvement []Involvement
+	// BotReview is where the latest review bot request stands; BotRequestedBy
+	// and BotRequestedAt say who asked and when, BotAnsweredAt when the bot
+	// last answered.
+	BotReview      BotStatus
+	BotRequestedBy string
+	BotRequestedAt time.Time
+	BotAnsweredAt  time.Time
+	// SnoozeEnded is set when the PR's snooze ran out and it hasn't been
+	// seen since.
+	SnoozeEnded bool
+	// Unread is set when the PR has an unread GitHub notification thread
+	// (NotificationThread, kept after it's marked read).
+	Unread             bool
+	NotificationThread string
+	// MyReviewOID is the commit my last review was made against and HeadOID
+	// the current head; InterdiffURL is set once the range between them has
+	// been checked (see resolveInterdiff).
+	MyReviewOID  string
+	HeadOID      string
+	InterdiffURL string
+	// RequestedAt is when my outstanding review request was made; SLA is
+	// how that compares with the configured thresholds.
+	RequestedAt time.Time
+	SLA         SLAState
+	// Priority is the sort bucket after rules are applied, and
+	// PriorityExplain the steps that produced it. Set by sortWithDraftsLast.
+	Priority        int
+	PriorityExplain []string
+	// MyReviewAt is when my deciding review was submitted and HeadCommitAt
+	// when the head commit was made. OtherReviews is each other reviewer's
+	// latest review, CheckState the head commit's check rollup and
+	// CodeOwnerVia who my codeowner review was requested through. They're
+	// what the indicators were decided on, kept for the explanation.
+	MyReviewAt   time.Time
+	HeadCommitAt time.Time
+	OtherReviews []OtherReview
+	CheckState   string
+	CodeOwnerVia []string
 	RepoName     string
 	RepoFullName string
 	Number       int
@@ -69,30 +184,65 @@ type ClassifiedPR struct {
 	URL          string
 	CreatedAt    time.Time
 	LastActivity time.Time
+	// Age is the time since CreatedAt, in working time when a calendar is
+	// configured.
+	Age time.Duration
 }
 
-func computeMyReview(pr PRNode, me string) MyReviewIndicator {
-	var lastReview *ReviewNode
+// myDecidingReview returns my review that decides the MyReview indicator:
+// my latest submitted review, or nil if I have none. pending is true if I
+// also have an unsubmitted draft review, which takes precedence.
+func myDecidingReview(pr PRNode, me string) (last *ReviewNode, pending bool) {
 	for i := range pr.Reviews.Nodes {
 		r := &pr.Reviews.Nodes[i]
 		if r.Author.Login != me {
 			continue
 		}
 		switch r.State {
+		case "PENDING":
+			pending = true
 		case "APPROVED", "CHANGES_REQUESTED", "COMMENTED":
-			lastReview = r
+			last = r
 		}
 	}
+	return last, pending
+}
+
+func lastCommitTime(pr PRNode) time.Time {
+	if len(pr.Commits.Nodes) > 0 {
+		return pr.Commits.Nodes[0].Commit.CommittedDate
+	}
+	return time.Time{}
+}
 
+func computeMyReview(pr PRNode, me string) MyReviewIndicator {
+	lastReview, pending := myDecidingReview(pr, me)
+	return myReviewIndicator(lastReview, pending, lastCommitTime(pr))
+}
+
+// setMyReview fills in MyReview and the review and commit times behind it.
+func (c *ClassifiedPR) setMyReview(pr PRNode, me string) {
+	lastReview, pending := myDecidingReview(pr, me)
+	c.HeadCommitAt = lastCommitTime(pr)
+	c.MyReview = myReviewIndicator(lastReview, pending, c.HeadCommitAt)
+	if lastReview != nil {
+		c.MyReviewAt = lastReview.SubmittedAt
+	}
+}
+
+// myReviewIndicator decides MyReview from my deciding review; it's stale
+// if the head commit came after it.
+func myReviewIndicator(lastReview *ReviewNode, pending bool, headAt time.Time) MyReviewIndicator {
+	if pending {
+		// An unsubmitted draft review outranks anything already
+		// submitted: it's work I started and haven't finished.
+		return MyPending
+	}
 	if lastReview == nil {
 		return MyNone
 	}
 
-	stale := false
-	if len(pr.Commits.Nodes) > 0 {
-		lastCommit := pr.Commits.Nodes[0].Commit.CommittedDate
-		stale = lastCommit.After(lastReview.SubmittedAt)
-	}
+	stale := headAt.After(lastReview.SubmittedAt)
 
 	switch lastReview.State {
 	case "APPROVED":
@@ -114,7 +264,17 @@ func computeMyReview(pr PRNode, me string) MyReviewIndicator {
 	return MyNone
 }
 
-func computeOthReview(pr PRNode, me string) OthReviewIndicator {
+func isStaleReview(r MyReviewIndicator) bool {
+	switch r {
+	case MyApprovedStale, MyChangesStale, MyCommentedStale:
+		return true
+	}
+	return false
+}
+
+// latestOtherReviews returns each other reviewer's most recent submitted,
+// non-dismissed review.
+func latestOtherReviews(pr PRNode, me string) map[string]ReviewNode {
 	latest := make(map[string]ReviewNode)
 	for _, r := range pr.Reviews.Nodes {
 		if r.Author.Login == "" || r.Author.Login == me {
@@ -131,7 +291,31 @@ func computeOthReview(pr PRNode, me string) OthReviewIndicator {
 			latest[r.Author.Login] = r
 		}
 	}
+	return latest
+}
+
+// OtherReview is another reviewer's latest review state.
+type OtherReview struct {
+	Login string
+	State string
+}
+
+// reviewerStates lists each other reviewer's latest review, by login.
+func reviewerStates(pr PRNode, me string) []OtherReview {
+	latest := latestOtherReviews(pr, me)
+	states := make([]OtherReview, 0, len(latest))
+	for login, r := range latest {
+		states = append(states, OtherReview{login, r.State})
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].Login < states[j].Login })
+	return states
+}
+
+func computeOthReview(pr PRNode, me string) OthReviewIndicator {
+	return othReviewIndicator(reviewerStates(pr, me))
+}
 
+func othReviewIndicator(latest []OtherReview) OthReviewIndicator {
 	if len(latest) == 0 {
 		return OthNone
 	}
@@ -197,6 +381,125 @@ func computeActivity(pr PRNode, me string) ActivityIndicator {
 	return ActNone
 }
 
+// mentionPattern matches an @-mention of me or of any of my teams, written
+// as @org/slug. GitHub logins and slugs may contain hyphens, so the mention
+// must end at a character that can't continue the name.
+func mentionPattern(me, org string, myTeams map[string]bool) *regexp.Regexp {
+	names := []string{regexp.QuoteMeta(me)}
+	if org != "" {
+		for slug := range myTeams {
+			names = append(names, regexp.QuoteMeta(org+"/"+slug))
+		}
+	}
+	return regexp.MustCompile(`(?i)(?:^|[^\w@/.-])@(?:` + strings.Join(names, "|") + `)(?:[^\w/-]|$)`)
+}
+
+// mentionMatcher finds @-mentions of me or my teams. Team mentions name
+// the org, so it compiles one pattern per org and reuses it for every PR
+// in a classify pass.
+type mentionMatcher struct {
+	me      string
+	myTeams map[string]bool
+	byOrg   map[string]*regexp.Regexp
+}
+
+func newMentionMatcher(me string, myTeams map[string]bool) *mentionMatcher {
+	return &mentionMatcher{me: me, myTeams: myTeams, byOrg: make(map[string]*regexp.Regexp)}
+}
+
+// mentions reports whether body mentions me or one of my teams, for a PR
+// in pr's org.
+func (mm *mentionMatcher) mentions(pr PRNode, body string) bool {
+	if body == "" {
+		return false
+	}
+	org, _, _ := strings.Cut(pr.Repository.NameWithOwner, "/")
+	re, ok := mm.byOrg[org]
+	if !ok {
+		re = mentionPattern(mm.me, org, mm.myTeams)
+		mm.byOrg[org] = re
+	}
+	return re.MatchString(body)
+}
+
+// authoredText is a piece of a PR someone wrote: its body, a comment, a
+// review or an inline review comment.
+type authoredText struct {
+	login string
+	body  string
+	at    time.Time
+}
+
+// prTexts lists everything written on a PR, in no particular order. Only
+// the latest few comments of each review thread have a body.
+func prTexts(pr PRNode) []authoredText {
+	texts := []authoredText{{pr.Author.Login, pr.Body, pr.CreatedAt}}
+	for _, c := range pr.Comments.Nodes {
+		texts = append(texts, authoredText{c.Author.Login, c.Body, c.CreatedAt})
+	}
+	for _, r := range pr.Reviews.Nodes {
+		texts = append(texts, authoredText{r.Author.Login, r.Body, r.SubmittedAt})
+	}
+	for _, th := range pr.ReviewThreads.Nodes {
+		for _, c := range th.Comments.Nodes {
+			texts = append(texts, authoredText{c.Author.Login, c.Body, c.CreatedAt})
+		}
+		for _, c := range th.Recent.Nodes {
+			texts = append(texts, authoredText{c.Author.Login, c.Body, c.CreatedAt})
+		}
+	}
+	return texts
+}
+
+// hasUnansweredMention reports whether someone else @-mentioned me or one
+// of my teams, in the PR body or in a comment, review or inline review
+// comment, after anything I last wrote on the PR.
+func hasUnansweredMention(pr PRNode, me string, mm *mentionMatcher) bool {
+	if me == "" {
+		return false
+	}
+	texts := prTexts(pr)
+	var myLast time.Time
+	for _, t := range texts[1:] {
+		if t.login == me && t.at.After(myLast) {
+			myLast = t.at
+		}
+	}
+	for _, t := range texts {
+		if t.login != me && t.at.After(myLast) && mm.mentions(pr, t.body) {
+			return true
+		}
+	}
+	return false
+}
+
+// computeThreads counts unresolved review threads and, among those, the
+// ones I started or replied to where someone else has the last word.
+func computeThreads(pr PRNode, me string) (unresolved, awaitingMe int) {
+	for _, th := range pr.ReviewThreads.Nodes {
+		if th.IsResolved {
+			continue
+		}
+		unresolved++
+		var myLast, othersLast time.Time
+		for _, c := range th.Comments.Nodes {
+			if c.Author.Login == me {
+				if c.CreatedAt.After(myLast) {
+					myLast = c.CreatedAt
+				}
+			} else if c.Author.Login != "" {
+				if c.CreatedAt.After(othersLast) {
+					othersLast = c.CreatedAt
+				}
+			}
+		}
+		if !myLast.IsZero() && othersLast.After(myLast) {
+			awaitingMe++
+		}
+	}
+	return unresolved, awaitingMe
+}
+
 func computeAuthorActivity(pr PRNode) ActivityIndicator {
 	if len(pr.Comments.Nodes) == 0 && len(pr.Reviews.Nodes) == 0 {
 		return ActNone
@@ -240,6 +543,15 @@ func computeLastActivity(pr PRNode) time.Time {
 	return latest
 }
 
+// checkState is the head commit's check rollup state, or "" if it has
+// none.
+func checkState(pr PRNode) string {
+	if len(pr.Commits.Nodes) > 0 && pr.Commits.Nodes[0].Commit.StatusCheckRollup != nil {
+		return pr.Commits.Nodes[0].Commit.StatusCheckRollup.State
+	}
+	return ""
+}
+
 func computeStatus(pr PRNode) StatusIndicator {
 	// Conflict takes highest priority
 	if pr.Mergeable == "CONFLICTING" {
@@ -247,20 +559,14 @@ func computeStatus(pr PRNode) StatusIndicator {
 	}
 
 	// CI status from head commit
-	if len(pr.Commits.Nodes) > 0 {
-		rollup := pr.Commits.Nodes[0].Commit.StatusCheckRollup
-		if rollup != nil {
-			switch rollup.State {
-			case "FAILURE", "ERROR":
-				return StatusFail
-			case "PENDING", "EXPECTED":
-				return StatusPending
-			case "SUCCESS":
-				return StatusPass
-			}
-		}
+	switch checkState(pr) {
+	case "FAILURE", "ERROR":
+		return StatusFail
+	case "PENDING", "EXPECTED":
+		return StatusPending
+	case "SUCCESS":
+		return StatusPass
 	}
-
 	return StatusNone
 }
 
@@ -276,56 +582,207 @@ func isRequestedReviewer(pr PRNode, me string, myTeams map[string]bool) bool {
 	return false
 }
 
+// computeRequestKind reports the strongest way a review was requested from
+// me: a direct user request beats a codeowner team request, which beats a
+// plain team request.
+func computeRequestKind(pr PRNode, me string, myTeams map[string]bool) RequestKind {
+	kind := RequestNone
+	for _, rr := range pr.ReviewRequests.Nodes {
+		if rr.RequestedReviewer.Login != "" && rr.RequestedReviewer.Login == me {
+			return RequestDirect
+		}
+		if rr.RequestedReviewer.Slug == "" || !myTeams[rr.RequestedReviewer.Slug] {
+			continue
+		}
+		if rr.AsCodeOwner {
+			kind = RequestCodeOwnerTeam
+		} else if kind == RequestNone {
+			kind = RequestTeam
+		}
+	}
+	return kind
+}
+
+// computeTeamSatisfiedBy returns the teammate who already approved or
+// requested changes for the teams my review was requested through. It
+// returns "" if I was asked directly, if any of my requested teams still
+// has no review from a member, or if no team rosters are known.
+func computeTeamSatisfiedBy(pr PRNode, me string, myTeams map[string]bool, teamMembers map[string][]string) string {
+	// Latest deciding review per teammate login
+	reviewed := make(map[string]time.Time)
+	for _, r := range pr.Reviews.Nodes {
+		if r.Author.Login == "" || r.Author.Login == me || r.Author.Login == pr.Author.Login {
+			continue
+		}
+		if r.State != "APPROVED" && r.State != "CHANGES_REQUESTED" {
+			continue
+		}
+		if at, ok := reviewed[r.Author.Login]; !ok || r.SubmittedAt.After(at) {
+			reviewed[r.Author.Login] = r.SubmittedAt
+		}
+	}
+
+	var by string
+	var byAt time.Time
+	teamRequests := 0
+	for _, rr := range pr.ReviewRequests.Nodes {
+		if rr.RequestedReviewer.Login != "" && rr.RequestedReviewer.Login == me {
+			return ""
+		}
+		slug := rr.RequestedReviewer.Slug
+		if slug == "" || !myTeams[slug] {
+			continue
+		}
+		teamRequests++
+		covered := false
+		for _, member := range teamMembers[slug] {
+			at, ok := reviewed[member]
+			if !ok {
+				continue
+			}
+			covered = true
+			if by == "" || at.After(byAt) {
+				by, byAt = member, at
+			}
+		}
+		if !covered {
+			return ""
+		}
+	}
+	if teamRequests == 0 {
+		return ""
+	}
+	return by
+}
+
 func isCodeOwnerReviewer(pr PRNode, me string, myTeams map[string]bool) bool {
+	return len(codeOwnerVia(pr, me, myTeams)) > 0
+}
+
+// codeOwnerVia lists who my codeowner review was requested through: "you
+// directly" or "team slug".
+func codeOwnerVia(pr PRNode, me string, myTeams map[string]bool) []string {
+	var via []string
 	for _, rr := range pr.ReviewRequests.Nodes {
 		if !rr.AsCodeOwner {
 			continue
 		}
-		if rr.RequestedReviewer.Login == me {
-			return true
+		switch {
+		case rr.RequestedReviewer.Login != "" && rr.RequestedReviewer.Login == me:
+			via = append(via, "you directly")
+		case rr.RequestedReviewer.Slug != "" && myTeams[rr.RequestedReviewer.Slug]:
+			via = append(via, "team "+rr.RequestedReviewer.Slug)
 		}
-		if rr.RequestedReviewer.Slug != "" && myTeams[rr.RequestedReviewer.Slug] {
-			return true
+	}
+	return via
+}
+
+// computeRequestedTeams lists the teams a review was requested from, by
+// slug, sorted. Only teams in myTeams count unless it's nil.
+func computeRequestedTeams(pr PRNode, myTeams map[string]bool) []string {
+	org, _, _ := strings.Cut(pr.Repository.NameWithOwner, "/")
+	var teams []string
+	for _, rr := range pr.ReviewRequests.Nodes {
+		slug := rr.RequestedReviewer.Slug
+		if slug == "" || (myTeams != nil && !myTeams[slug]) {
+			continue
 		}
+		teams = append(teams, org+"/"+slug)
 	}
-	return false
+	sort.Strings(teams)
+	return teams
 }
 
 func sortPriority(pr ClassifiedPR) int {
-	// 0: Unreviewed codeowner PRs — you own this code
-	if pr.MyReview == MyNone && pr.IsCodeOwner {
+	// 0: My unsubmitted draft review — finish it
+	if pr.MyReview == MyPending {
 		return 0
 	}
-	// 1: I requested changes (including stale)
-	if pr.MyReview == MyChanges || pr.MyReview == MyChangesStale {
+	// 1: My review request is past its SLA breach threshold
+	if pr.SLA == SLABreach {
 		return 1
 	}
-	// 2: I left a comment review (including stale)
-	if pr.MyReview == MyCommented || pr.MyReview == MyCommentedStale {
+	// 2: Someone @-mentioned me or my team since I last spoke
+	if pr.Activity == ActMentioned {
 		return 2
 	}
-	// 3: Stale approvals — new commits since I approved
-	if pr.MyReview == MyApprovedStale {
+	// 3: Unreviewed, requested from me personally
+	if pr.MyReview == MyNone && pr.RequestedVia == RequestDirect {
 		return 3
 	}
-	// 4: Everything else (sorted by date within this bucket)
-	return 4
+	// 4: Unreviewed codeowner PRs — you own this code, unless a
+	// teammate already reviewed for the team
+	if pr.MyReview == MyNone && pr.IsCodeOwner && pr.TeamSatisfiedBy == "" {
+		return 4
+	}
+	// 5: I requested changes (including stale)
+	if pr.MyReview == MyChanges || pr.MyReview == MyChangesStale {
+		return 5
+	}
+	// 6: I left a comment review (including stale)
+	if pr.MyReview == MyCommented || pr.MyReview == MyCommentedStale {
+		return 6
+	}
+	// 7: Stale approvals — new commits since I approved
+	if pr.MyReview == MyApprovedStale {
+		return 7
+	}
+	// 8: Unreviewed, requested from one of my teams and not yet covered
+	if pr.MyReview == MyNone && pr.RequestedVia == RequestTeam && pr.TeamSatisfiedBy == "" {
+		return 8
+	}
+	// 9: Everything else (sorted by date within this bucket), including
+	// team requests a teammate already covered
+	return 9
 }
 
-func classifyAll(prs []PRNode, me string, myTeams map[string]bool, filter func(PRNode) bool, sortMode SortMode) []ClassifiedPR {
+func labelNames(pr PRNode) []string {
+	var names []string
+	for _, l := range pr.Labels.Nodes {
+		names = append(names, l.Name)
+	}
+	return names
+}
+
+func classifyAll(prs []PRNode, me string, myTeams map[string]bool, teamMembers map[string][]string, filter func(PRNode) bool, sortMode SortMode, cfg Config) []ClassifiedPR {
 	var result []ClassifiedPR
+	mentions := newMentionMatcher(me, myTeams)
 	for _, pr := range prs {
 		if filter != nil && !filter(pr) {
 			continue
 		}
+		activity := computeActivity(pr, me)
+		if hasUnansweredMention(pr, me, mentions) {
+			activity = ActMentioned
+		}
+		unresolved, awaitingMe := computeThreads(pr, me)
+		reviewers := reviewerStates(pr, me)
+		ownerVia := codeOwnerVia(pr, me, myTeams)
 		result = append(result, ClassifiedPR{
-			MyReview:     computeMyReview(pr, me),
-			OthReview:    computeOthReview(pr, me),
-			Activity:     computeActivity(pr, me),
+			OthReview:    othReviewIndicator(reviewers),
+			OtherReviews: reviewers,
+			Activity:     activity,
 			Status:       computeStatus(pr),
+			CheckState:   checkState(pr),
 			IsDraft:      pr.IsDraft,
 			IsAuthor:     pr.Author.Login == me,
-			IsCodeOwner:  isCodeOwnerReviewer(pr, me, myTeams),
+			IsCodeOwner:  len(ownerVia) > 0,
+			CodeOwnerVia: ownerVia,
+			RequestedVia: computeRequestKind(pr, me, myTeams),
+			TeamSatisfiedBy: computeTeamSatisfiedBy(pr, me, myTeams, teamMembers),
+			RequestedTeams: computeRequestedTeams(pr, myTeams),
+			UnresolvedThreads: unresolved,
+			ThreadsAwaitingMe: awaitingMe,
+			Labels:       labelNames(pr),
+			BaseRef:      pr.BaseRefName,
+			HeadRef:      pr.HeadRefName,
+			ForkHead:     pr.IsCrossRepository,
+			CommitCount:  pr.Commits.TotalCount,
+			CommentCount: pr.Comments.TotalCount,
+			ReviewCount:  pr.Reviews.TotalCount,
+			MyReviewOID:  myReviewOID(pr, me),
+			HeadOID:      pr.HeadRefOid,
+			RequestedAt:  computeRequestedAt(pr, me, myTeams),
 			RepoName:     pr.Repository.Name,
 			RepoFullName: pr.Repository.NameWithOwner,
 			Number:       pr.Number,
@@ -335,10 +792,17 @@ func classifyAll(prs []PRNode, me string, myTeams map[string]bool, filter func(P
 			CreatedAt:    pr.CreatedAt,
 			LastActivity: computeLastActivity(pr),
 		})
+		c := &result[len(result)-1]
+		c.setMyReview(pr, me)
+		c.Age = cfg.Calendar.since(pr.CreatedAt)
+		c.setSize(pr, cfg.Sizes)
+		c.Involvement = computeInvolvement(pr, me, myTeams, mentions)
+		c.SLA = computeSLA(*c, cfg.SLA, cfg.Calendar)
+		c.setBot(pr, cfg.Bot)
 	}
 
-	sortWithDraftsLast(result, sortMode, sortPriority)
-	return result
+	sortWithDraftsLast(result, sortMode, sortPriority, cfg.Rules)
+	return linkStacks(result, hasCurrentReview)
 }
 
 func authorSortPriority(pr ClassifiedPR) int {
@@ -355,7 +819,13 @@ func authorSortPriority(pr ClassifiedPR) int {
 	return 4
 }
 
-func sortWithDraftsLast(result []ClassifiedPR, sortMode SortMode, priorityFn func(ClassifiedPR) int) {
+// sortWithDraftsLast orders PRs with drafts at the bottom. In priority mode
+// each PR's bucket comes from priorityFn adjusted by rules; ties, and date
+// mode, fall back to most recent activity first.
+func sortWithDraftsLast(result []ClassifiedPR, sortMode SortMode, priorityFn func(ClassifiedPR) int, rules []SortRule) {
+	for i := range result {
+		result[i].Priority, result[i].PriorityExplain = applyRules(result[i], rules, priorityFn)
+	}
 	switch sortMode {
 	case SortDate:
 		sort.Slice(result, func(i, j int) bool {
@@ -364,12 +834,36 @@ func sortWithDraftsLast(result []ClassifiedPR, sortMode SortMode, priorityFn fun
 			}
 			return result[i].LastActivity.After(result[j].LastActivity)
 		})
+	case SortSmall, SortQuickWins:
+		sort.Slice(result, func(i, j int) bool {
+			if result[i].IsDraft != result[j].IsDraft {
+				return !result[i].IsDraft
+			}
+			// Quick wins go first, smallest first; the rest keep priority order
+			bySize := true
+			if sortMode == SortQuickWins {
+				qi, qj := isQuickWin(result[i]), isQuickWin(result[j])
+				if qi != qj {
+					return qi
+				}
+				bySize = qi
+			}
+			li := result[i].Additions + result[i].Deletions
+			lj := result[j].Additions + result[j].Deletions
+			if bySize && li != lj {
+				return li < lj
+			}
+			if pi, pj := result[i].Priority, result[j].Priority; pi != pj {
+				return pi < pj
+			}
+			return result[i].LastActivity.After(result[j].LastActivity)
+		})
 	default:
 		sort.Slice(result, func(i, j int) bool {
 			if result[i].IsDraft != result[j].IsDraft {
 				return !result[i].IsDraft
 			}
-			pi, pj := priorityFn(result[i]), priorityFn(result[j])
+			pi, pj := result[i].Priority, result[j].Priority
 			if pi != pj {
 				return pi < pj
 			}
@@ -378,18 +872,39 @@ func sortWithDraftsLast(result []ClassifiedPR, sortMode SortMode, priorityFn fun
 	}
 }
 
-func classifyAllAuthor(prs []PRNode, me string, sortMode SortMode) []ClassifiedPR {
+// setSize fills in the diff size fields.
+func (c *ClassifiedPR) setSize(pr PRNode, sizes SizeConfig) {
+	c.Additions, c.Deletions, c.ChangedFiles = pr.Additions, pr.Deletions, pr.ChangedFiles
+	c.Size, _ = sizes.classify(pr.Additions + pr.Deletions)
+}
+
+func classifyAllAuthor(prs []PRNode, me string, sortMode SortMode, cfg Config) []ClassifiedPR {
 	var result []ClassifiedPR
+	mentions := newMentionMatcher(me, nil)
 	for _, pr := range prs {
 		if pr.Author.Login != me {
 			continue
 		}
+		unresolved, awaitingMe := computeThreads(pr, me)
+		reviewers := reviewerStates(pr, me)
 		result = append(result, ClassifiedPR{
 			MyReview:     MyNone,
-			OthReview:    computeOthReview(pr, me),
+			OthReview:    othReviewIndicator(reviewers),
+			OtherReviews: reviewers,
 			Activity:     computeAuthorActivity(pr),
 			Status:       computeStatus(pr),
+			CheckState:   checkState(pr),
 			IsDraft:      pr.IsDraft,
+			RequestedVia: RequestNone,
+			UnresolvedThreads: unresolved,
+			ThreadsAwaitingMe: awaitingMe,
+			Labels:       labelNames(pr),
+			BaseRef:      pr.BaseRefName,
+			HeadRef:      pr.HeadRefName,
+			ForkHead:     pr.IsCrossRepository,
+			CommitCount:  pr.Commits.TotalCount,
+			CommentCount: pr.Comments.TotalCount,
+			ReviewCount:  pr.Reviews.TotalCount,
 			RepoName:     pr.Repository.Name,
 			RepoFullName: pr.Repository.NameWithOwner,
 			Number:       pr.Number,
@@ -398,9 +913,18 @@ func classifyAllAuthor(prs []PRNode, me string, sortMode SortMode) []ClassifiedP
 			URL:          pr.URL,
 			CreatedAt:    pr.CreatedAt,
 			LastActivity: computeLastActivity(pr),
+			HeadCommitAt: lastCommitTime(pr),
 		})
-	}
-
-	sortWithDraftsLast(result, sortMode, authorSortPriority)
-	return result
+		c := &result[len(result)-1]
+		c.setSize(pr, cfg.Sizes)
+		c.PendingReviewers = pendingReviewers(pr)
+		c.ChangesRequestedBy = changesRequestedBy(pr, me)
+		c.Readiness = computeReadiness(pr, me)
+		c.Involvement = computeInvolvement(pr, me, nil, mentions)
+		c.setBot(pr, cfg.Bot)
+		c.RequestedTeams = computeRequestedTeams(pr, nil)
+	}
+
+	sortWithDraftsLast(result, sortMode, authorSortPriority, nil)
+	return linkStacks(result, func(pr ClassifiedPR) bool { return pr.OthReview == OthApproved })
 }
@@ -108,6 +108,127 @@ func TestIsRequestedReviewer_OtherUser(t *testing.T) {
 	}
 }
 
+// --- computeRequestKind tests ---
+
+func TestComputeRequestKind(t *testing.T) {
+	myTeams := map[string]bool{"backend-team": true, "owners": true}
+	cases := []struct {
+		name string
+		pr   PRNode
+		want RequestKind
+	}{
+		{"none", makePR(), RequestNone},
+		{"direct", makePR(withReviewRequest("me", "", false)), RequestDirect},
+		{"team", makePR(withReviewRequest("", "backend-team", false)), RequestTeam},
+		{"codeowner team", makePR(withReviewRequest("", "owners", true)), RequestCodeOwnerTeam},
+		{"other team", makePR(withReviewRequest("", "frontend-team", true)), RequestNone},
+		{"direct beats team", makePR(
+			withReviewRequest("", "owners", true),
+			withReviewRequest("me", "", false),
+		), RequestDirect},
+		{"codeowner team beats team", makePR(
+			withReviewRequest("", "backend-team", false),
+			withReviewRequest("", "owners", true),
+		), RequestCodeOwnerTeam},
+	}
+	for _, tc := range cases {
+		if got := computeRequestKind(tc.pr, "me", myTeams); got != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestClassifyAll_DirectBeforeTeamRequest(t *testing.T) {
+	myTeams := map[string]bool{"backend-team": true}
+	prs := []PRNode{
+		makePR(withAuthor("alice"), withURL("https://github.com/org/repo/pull/1")),
+		makePR(withAuthor("bob"), withReviewRequest("", "backend-team", false), withURL("https://github.com/org/repo/pull/2")),
+		makePR(withAuthor("carol"), withReviewRequest("me", "", false), withURL("https://github.com/org/repo/pull/3")),
+	}
+	result := classifyAll(prs, "me", myTeams, nil, nil, SortPriority, Config{})
+	if result[0].Author != "carol" || result[0].RequestedVia != RequestDirect {
+		t.Errorf("expected direct request first, got %s (%s)", result[0].Author, result[0].RequestedVia)
+	}
+	if result[1].Author != "bob" || result[1].RequestedVia != RequestTeam {
+		t.Errorf("expected team request second, got %s (%s)", result[1].Author, result[1].RequestedVia)
+	}
+	if result[2].Author != "alice" {
+		t.Errorf("expected unrequested PR last, got %s", result[2].Author)
+	}
+}
+
+// --- computeTeamSatisfiedBy tests ---
+
+func TestComputeTeamSatisfiedBy(t *testing.T) {
+	myTeams := map[string]bool{"backend-team": true, "infra": true}
+	members := map[string][]string{
+		"backend-team": {"me", "bob", "carol"},
+		"infra":        {"me", "dave"},
+	}
+	at := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name string
+		pr   PRNode
+		want string
+	}{
+		{"teammate approved", makePR(
+			withReviewRequest("", "backend-team", false),
+			withReview("bob", "APPROVED", at),
+		), "bob"},
+		{"teammate only commented", makePR(
+			withReviewRequest("", "backend-team", false),
+			withReview("bob", "COMMENTED", at),
+		), ""},
+		{"non-member approved", makePR(
+			withReviewRequest("", "backend-team", false),
+			withReview("zed", "APPROVED", at),
+		), ""},
+		{"direct request not covered", makePR(
+			withReviewRequest("", "backend-team", false),
+			withReviewRequest("me", "", false),
+			withReview("bob", "APPROVED", at),
+		), ""},
+		{"one of two teams uncovered", makePR(
+			withReviewRequest("", "backend-team", false),
+			withReviewRequest("", "infra", false),
+			withReview("bob", "APPROVED", at),
+		), ""},
+		{"both teams covered, latest wins", makePR(
+			withReviewRequest("", "backend-team", false),
+			withReviewRequest("", "infra", true),
+			withReview("bob", "APPROVED", at),
+			withReview("dave", "CHANGES_REQUESTED", at.Add(time.Hour)),
+		), "dave"},
+		{"no team request", makePR(withReview("bob", "APPROVED", at)), ""},
+	}
+	for _, tc := range cases {
+		if got := computeTeamSatisfiedBy(tc.pr, "me", myTeams, members); got != tc.want {
+			t.Errorf("%s: got %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestClassifyAll_CoveredTeamRequestSortsLower(t *testing.T) {
+	myTeams := map[string]bool{"backend-team": true}
+	members := map[string][]string{"backend-team": {"me", "bob"}}
+	at := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	prs := []PRNode{
+		makePR(withAuthor("alice"), withReviewRequest("", "backend-team", true), withReview("bob", "APPROVED", at), withURL("https://github.com/org/repo/pull/1")),
+		makePR(withAuthor("carol"), withURL("https://github.com/org/repo/pull/2")),
+		makePR(withAuthor("dave"), withReviewRequest("", "backend-team", true), withURL("https://github.com/org/repo/pull/3")),
+	}
+	result := classifyAll(prs, "me", myTeams, members, nil, SortPriority, Config{})
+	if result[0].Author != "dave" {
+		t.Errorf("expected uncovered codeowner request first, got %s", result[0].Author)
+	}
+	for _, pr := range result {
+		if pr.Author == "alice" && pr.TeamSatisfiedBy != "bob" {
+			t.Errorf("expected alice's PR covered by bob, got %q", pr.TeamSatisfiedBy)
+		}
+	}
+}
+
 // --- computeMyReview tests ---
 
 func TestComputeMyReview_None(t *testing.T) {
@@ -165,12 +286,31 @@ func TestComputeMyReview_StaleChanges(t *testing.T) {
 	}
 }
 
-func TestComputeMyReview_SkipsPending(t *testing.T) {
+func TestComputeMyReview_Pending(t *testing.T) {
+	pr := makePR(
+		withReview("me", "PENDING", time.Time{}),
+	)
+	if got := computeMyReview(pr, "me"); got != MyPending {
+		t.Fatalf("expected MyPending, got %s", got)
+	}
+}
+
+func TestComputeMyReview_PendingOverridesSubmitted(t *testing.T) {
+	pr := makePR(
+		withReview("me", "APPROVED", time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)),
+		withReview("me", "PENDING", time.Time{}),
+	)
+	if got := computeMyReview(pr, "me"); got != MyPending {
+		t.Fatalf("expected MyPending to win over earlier approval, got %s", got)
+	}
+}
+
+func TestComputeMyReview_OthersPendingIgnored(t *testing.T) {
 	pr := makePR(
-		withReview("me", "PENDING", time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)),
+		withReview("someone", "PENDING", time.Time{}),
 	)
 	if got := computeMyReview(pr, "me"); got != MyNone {
-		t.Fatalf("expected MyNone (pending skipped), got %s", got)
+		t.Fatalf("expected MyNone for another user's pending review, got %s", got)
 	}
 }
 
@@ -382,6 +522,124 @@ func TestComputeActivity_ReviewNoComments(t *testing.T) {
 	}
 }
 
+// --- hasUnansweredMention tests ---
+
+func withBody(body string) func(*PRNode) {
+	return func(pr *PRNode) {
+		pr.Body = body
+	}
+}
+
+func withCommentBody(login, body string, at time.Time) func(*PRNode) {
+	return func(pr *PRNode) {
+		c := CommentNode{Body: body, CreatedAt: at}
+		c.Author.Login = login
+		pr.Comments.Nodes = append(pr.Comments.Nodes, c)
+	}
+}
+
+// withThreadComment adds a review thread with one inline comment.
+// withThreadComment adds a thread with one comment, its body only under
+// Recent as the search returns it.
+func withThreadComment(login, body string, at time.Time) func(*PRNode) {
+	return func(pr *PRNode) {
+		c := CommentNode{CreatedAt: at}
+		c.Author.Login = login
+		th := ReviewThreadNode{}
+		th.Comments.Nodes = []CommentNode{c}
+		c.Body = body
+		th.Recent.Nodes = []CommentNode{c}
+		pr.ReviewThreads.Nodes = append(pr.ReviewThreads.Nodes, th)
+	}
+}
+
+func TestHasUnansweredMention(t *testing.T) {
+	myTeams := map[string]bool{"backend": true}
+	t1 := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name string
+		pr   PRNode
+		want bool
+	}{
+		{"no mentions", makePR(withCommentBody("bob", "looks good", t1)), false},
+		{"mentioned in comment", makePR(withCommentBody("bob", "@me can you check?", t1)), true},
+		{"mentioned case-insensitively", makePR(withCommentBody("bob", "cc @Me", t1)), true},
+		{"team mention", makePR(withCommentBody("bob", "@org/backend thoughts?", t1)), true},
+		{"other team", makePR(withCommentBody("bob", "@org/frontend thoughts?", t1)), false},
+		{"login prefix is not a mention", makePR(withCommentBody("bob", "@me-too please", t1)), false},
+		{"email is not a mention", makePR(withCommentBody("bob", "mail bob@me.com", t1)), false},
+		{"mentioned in body", makePR(withBody("Fixes a bug. /cc @me")), true},
+		{"answered after mention", makePR(
+			withCommentBody("bob", "@me can you check?", t1),
+			withCommentAt("me", t2),
+		), false},
+		{"mentioned after my reply", makePR(
+			withCommentAt("me", t1),
+			withCommentBody("bob", "@me ping", t2),
+		), true},
+		{"self mention ignored", makePR(withCommentBody("me", "note to @me", t1)), false},
+		{"mentioned in inline comment", makePR(withThreadComment("bob", "@me is this right?", t1)), true},
+		{"inline mention answered inline", makePR(
+			withThreadComment("bob", "@me is this right?", t1),
+			withThreadComment("me", "yes", t2),
+		), false},
+	}
+	mm := newMentionMatcher("me", myTeams)
+	for _, tc := range cases {
+		if got := hasUnansweredMention(tc.pr, "me", mm); got != tc.want {
+			t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestClassifyAll_MentionSortsFirst(t *testing.T) {
+	t1 := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	prs := []PRNode{
+		makePR(withAuthor("alice"), withReviewRequest("me", "", false), withURL("https://github.com/org/repo/pull/1")),
+		makePR(withAuthor("bob"), withCommentBody("bob", "@me?", t1), withURL("https://github.com/org/repo/pull/2")),
+	}
+	result := classifyAll(prs, "me", nil, nil, nil, SortPriority, Config{})
+	if result[0].Author != "bob" || result[0].Activity != ActMentioned {
+		t.Errorf("expected mentioned PR first with ActMentioned, got %s (%s)", result[0].Author, result[0].Activity)
+	}
+}
+
+// --- computeThreads tests ---
+
+// withThread adds a review thread whose comments are by the given logins,
+// one hour apart in order.
+func withThread(resolved bool, logins ...string) func(*PRNode) {
+	return func(pr *PRNode) {
+		th := ReviewThreadNode{IsResolved: resolved}
+		at := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+		for i, login := range logins {
+			c := CommentNode{CreatedAt: at.Add(time.Duration(i) * time.Hour)}
+			c.Author.Login = login
+			th.Comments.Nodes = append(th.Comments.Nodes, c)
+		}
+		pr.ReviewThreads.Nodes = append(pr.ReviewThreads.Nodes, th)
+	}
+}
+
+func TestComputeThreads(t *testing.T) {
+	pr := makePR(
+		withThread(false, "me", "bob"),        // awaiting me
+		withThread(false, "bob", "me"),        // I replied last
+		withThread(false, "bob", "me", "bob"), // awaiting me
+		withThread(false, "bob", "carol"),     // not mine
+		withThread(true, "me", "bob"),         // resolved
+	)
+	unresolved, awaiting := computeThreads(pr, "me")
+	if unresolved != 4 {
+		t.Errorf("expected 4 unresolved threads, got %d", unresolved)
+	}
+	if awaiting != 2 {
+		t.Errorf("expected 2 threads awaiting me, got %d", awaiting)
+	}
+}
+
 // --- computeAuthorActivity tests ---
 
 func TestComputeAuthorActivity_None(t *testing.T) {
@@ -475,7 +733,7 @@ func TestClassifyAll_BasicIndicators(t *testing.T) {
 			withURL("https://github.com/org/repo/pull/2"),
 		),
 	}
-	result := classifyAll(prs, "me", nil, nil, SortPriority)
+	result := classifyAll(prs, "me", nil, nil, nil, SortPriority, Config{})
 	if len(result) != 2 {
 		t.Fatalf("expected 2 PRs, got %d", len(result))
 	}
@@ -512,7 +770,7 @@ func TestClassifyAll_IncludesSelfWithIsAuthor(t *testing.T) {
 		makePR(withAuthor("me")),
 		makePR(withAuthor("other")),
 	}
-	result := classifyAll(prs, "me", nil, nil, SortPriority)
+	result := classifyAll(prs, "me", nil, nil, nil, SortPriority, Config{})
 	if len(result) != 2 {
 		t.Fatalf("expected 2 PRs, got %d", len(result))
 	}
@@ -535,7 +793,7 @@ func TestClassifyAll_WithFilter(t *testing.T) {
 	filter := func(pr PRNode) bool {
 		return isRequestedReviewer(pr, "me", nil)
 	}
-	result := classifyAll(prs, "me", nil, filter, SortPriority)
+	result := classifyAll(prs, "me", nil, nil, filter, SortPriority, Config{})
 	if len(result) != 1 {
 		t.Fatalf("expected 1 PR, got %d", len(result))
 	}
@@ -549,68 +807,78 @@ func TestClassifyAll_SortOrder(t *testing.T) {
 
 	myTeams := map[string]bool{"my-team": true}
 	prs := []PRNode{
-		// Approved (everything else bucket)
+		// Approved, nothing new (bucket 9)
 		makePR(
 			withAuthor("alice"),
 			withReview("me", "APPROVED", reviewTime),
 			withLastCommit(commitBefore),
 			withURL("https://github.com/org/repo/pull/1"),
 		),
-		// Unreviewed, no codeowner (everything else bucket)
+		// Unreviewed, not requested (bucket 9)
 		makePR(
 			withAuthor("bob"),
 			withURL("https://github.com/org/repo/pull/2"),
 		),
-		// Stale approval (priority 3)
+		// Stale approval (bucket 7)
 		makePR(
 			withAuthor("carol"),
 			withReview("me", "APPROVED", reviewTime),
 			withLastCommit(commitAfter),
 			withURL("https://github.com/org/repo/pull/3"),
 		),
-		// Changes requested (priority 1)
+		// Changes requested (bucket 5)
 		makePR(
 			withAuthor("dave"),
 			withReview("me", "CHANGES_REQUESTED", reviewTime),
 			withLastCommit(commitBefore),
 			withURL("https://github.com/org/repo/pull/4"),
 		),
-		// Commented review (priority 2)
+		// Commented review (bucket 6)
 		makePR(
 			withAuthor("eve"),
 			withReview("me", "COMMENTED", commentTime),
 			withLastCommit(commitBefore),
 			withURL("https://github.com/org/repo/pull/5"),
 		),
-		// Unreviewed codeowner (priority 0)
+		// Unreviewed, requested from me directly as codeowner (bucket 3)
 		makePR(
 			withAuthor("frank"),
 			withReviewRequest("me", "", true),
 			withURL("https://github.com/org/repo/pull/6"),
 		),
+		// Pending draft review (bucket 0)
+		makePR(
+			withAuthor("grace"),
+			withReview("me", "PENDING", time.Time{}),
+			withURL("https://github.com/org/repo/pull/7"),
+		),
+	}
+	result := classifyAll(prs, "me", myTeams, nil, nil, SortPriority, Config{})
+	if len(result) != 7 {
+		t.Fatalf("expected 7 PRs, got %d", len(result))
 	}
-	result := classifyAll(prs, "me", myTeams, nil, SortPriority)
-	if len(result) != 6 {
-		t.Fatalf("expected 6 PRs, got %d", len(result))
+	// Bucket 0: my unsubmitted review
+	if result[0].Author != "grace" {
+		t.Errorf("expected pending review first, got author=%s", result[0].Author)
 	}
-	// Priority 0: unreviewed codeowner
-	if result[0].Author != "frank" {
-		t.Errorf("expected unreviewed codeowner first, got author=%s", result[0].Author)
+	// Bucket 3: unreviewed, requested from me directly
+	if result[1].Author != "frank" {
+		t.Errorf("expected direct codeowner request second, got author=%s", result[1].Author)
 	}
-	// Priority 1: changes requested
-	if result[1].Author != "dave" {
-		t.Errorf("expected changes requested second, got author=%s", result[1].Author)
+	// Bucket 5: changes requested
+	if result[2].Author != "dave" {
+		t.Errorf("expected changes requested third, got author=%s", result[2].Author)
 	}
-	// Priority 2: commented
-	if result[2].Author != "eve" {
-		t.Errorf("expected commented third, got author=%s", result[2].Author)
+	// Bucket 6: commented
+	if result[3].Author != "eve" {
+		t.Errorf("expected commented fourth, got author=%s", result[3].Author)
 	}
-	// Priority 3: stale approval
-	if result[3].Author != "carol" {
-		t.Errorf("expected stale approval fourth, got author=%s", result[3].Author)
+	// Bucket 7: stale approval
+	if result[4].Author != "carol" {
+		t.Errorf("expected stale approval fifth, got author=%s", result[4].Author)
 	}
-	// Priority 4: everything else (approved and unreviewed non-codeowner, by date)
-	if result[4].MyReview == result[5].MyReview {
+	// Bucket 9: everything else (approved, and unreviewed without a request), by date
+	if result[5].MyReview == result[6].MyReview {
 		// both in same bucket, sorted by last activity
 	}
 }
@@ -618,7 +886,7 @@ func TestClassifyAll_SortOrder(t *testing.T) {
 func TestClassifyAll_DraftField(t *testing.T) {
 	pr := makePR(withAuthor("alice"))
 	pr.IsDraft = true
-	result := classifyAll([]PRNode{pr}, "me", nil, nil, SortPriority)
+	result := classifyAll([]PRNode{pr}, "me", nil, nil, nil, SortPriority, Config{})
 	if len(result) != 1 {
 		t.Fatalf("expected 1 PR, got %d", len(result))
 	}
@@ -632,7 +900,7 @@ func TestClassifyAll_DraftsSortLast(t *testing.T) {
 	draft.IsDraft = true
 	normal := makePR(withAuthor("bob"), withURL("https://github.com/org/repo/pull/2"))
 
-	result := classifyAll([]PRNode{draft, normal}, "me", nil, nil, SortPriority)
+	result := classifyAll([]PRNode{draft, normal}, "me", nil, nil, nil, SortPriority, Config{})
 	if len(result) != 2 {
 		t.Fatalf("expected 2 PRs, got %d", len(result))
 	}
@@ -650,7 +918,7 @@ func TestClassifyAll_DraftsSortLastDateMode(t *testing.T) {
 	draft.CreatedAt = time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC) // newer
 	normal := makePR(withAuthor("bob"), withURL("https://github.com/org/repo/pull/2"))
 
-	result := classifyAll([]PRNode{draft, normal}, "me", nil, nil, SortDate)
+	result := classifyAll([]PRNode{draft, normal}, "me", nil, nil, nil, SortDate, Config{})
 	if len(result) != 2 {
 		t.Fatalf("expected 2 PRs, got %d", len(result))
 	}
@@ -659,6 +927,56 @@ func TestClassifyAll_DraftsSortLastDateMode(t *testing.T) {
 	}
 }
 
+func withDiff(additions, deletions, files int) func(*PRNode) {
+	return func(pr *PRNode) {
+		pr.Additions, pr.Deletions, pr.ChangedFiles = additions, deletions, files
+	}
+}
+
+func TestClassifyAll_SortSmall(t *testing.T) {
+	prs := []PRNode{
+		makePR(withAuthor("big"), withDiff(800, 200, 30)),
+		makePR(withAuthor("tiny"), withDiff(3, 1, 1)),
+		makePR(withAuthor("mid"), withDiff(100, 20, 4)),
+	}
+	result := classifyAll(prs, "me", nil, nil, nil, SortSmall, Config{})
+	var got []string
+	for _, pr := range result {
+		got = append(got, pr.Author+"/"+string(pr.Size))
+	}
+	want := []string{"tiny/XS", "mid/M", "big/L"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("order = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestClassifyAll_SortQuickWins(t *testing.T) {
+	prs := []PRNode{
+		makePR(withAuthor("big"), withDiff(800, 200, 30), withReviewRequest("me", "", false)),
+		makePR(withAuthor("reviewed"), withDiff(2, 0, 1), withReview("me", "APPROVED", time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC))),
+		makePR(withAuthor("small"), withDiff(30, 5, 2)),
+		makePR(withAuthor("tiny"), withDiff(3, 1, 1)),
+	}
+	result := classifyAll(prs, "me", nil, nil, nil, SortQuickWins, Config{})
+	want := []string{"tiny", "small", "big", "reviewed"}
+	for i, pr := range result {
+		if pr.Author != want[i] {
+			t.Fatalf("position %d: got %s, want %s (quick wins by size, then priority)", i, pr.Author, want[i])
+		}
+	}
+}
+
+func TestParseSortMode(t *testing.T) {
+	if m, err := parseSortMode("quick-wins"); err != nil || m != SortQuickWins {
+		t.Errorf("parseSortMode(quick-wins) = %q, %v", m, err)
+	}
+	if _, err := parseSortMode("biggest"); err == nil {
+		t.Error("expected error for unknown sort")
+	}
+}
+
 func TestClassifyAll_IncludesApproved(t *testing.T) {
 	reviewTime := time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)
 	commitBefore := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
@@ -669,7 +987,7 @@ func TestClassifyAll_IncludesApproved(t *testing.T) {
 			withLastCommit(commitBefore),
 		),
 	}
-	result := classifyAll(prs, "me", nil, nil, SortPriority)
+	result := classifyAll(prs, "me", nil, nil, nil, SortPriority, Config{})
 	if len(result) != 1 {
 		t.Fatalf("expected 1 PR (approved PRs no longer hidden), got %d", len(result))
 	}
@@ -682,7 +1000,7 @@ func TestClassifyAllAuthor_OnlyOwnPRs(t *testing.T) {
 		makePR(withAuthor("me")),
 		makePR(withAuthor("other")),
 	}
-	result := classifyAllAuthor(prs, "me", SortPriority)
+	result := classifyAllAuthor(prs, "me", SortPriority, Config{})
 	if len(result) != 1 {
 		t.Fatalf("expected 1 PR (only mine), got %d", len(result))
 	}
@@ -691,7 +1009,7 @@ func TestClassifyAllAuthor_OnlyOwnPRs(t *testing.T) {
 func TestClassifyAllAuthor_DraftIndicator(t *testing.T) {
 	pr := makePR(withAuthor("me"))
 	pr.IsDraft = true
-	result := classifyAllAuthor([]PRNode{pr}, "me", SortPriority)
+	result := classifyAllAuthor([]PRNode{pr}, "me", SortPriority, Config{})
 	if !result[0].IsDraft {
 		t.Fatal("expected IsDraft to be true")
 	}
@@ -703,7 +1021,7 @@ func TestClassifyAllAuthor_ReviewIndicators(t *testing.T) {
 		withReview("alice", "APPROVED", time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)),
 		withReview("bob", "CHANGES_REQUESTED", time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)),
 	)
-	result := classifyAllAuthor([]PRNode{pr}, "me", SortPriority)
+	result := classifyAllAuthor([]PRNode{pr}, "me", SortPriority, Config{})
 	if result[0].OthReview != OthChanges {
 		t.Fatalf("expected OthChanges, got %s", result[0].OthReview)
 	}
@@ -716,7 +1034,7 @@ func TestClassifyAllAuthor_ActivityIndicator(t *testing.T) {
 		withLastCommit(commitTime),
 		withCommentAt("alice", time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)),
 	)
-	result := classifyAllAuthor([]PRNode{pr}, "me", SortPriority)
+	result := classifyAllAuthor([]PRNode{pr}, "me", SortPriority, Config{})
 	if result[0].Activity != ActMine {
 		t.Fatalf("expected ActMine (new comments since push), got %s", result[0].Activity)
 	}
@@ -736,7 +1054,7 @@ func TestClassifyAllAuthor_SortOrder(t *testing.T) {
 			withURL("https://github.com/org/repo/pull/3"),
 		),
 	}
-	result := classifyAllAuthor(prs, "me", SortPriority)
+	result := classifyAllAuthor(prs, "me", SortPriority, Config{})
 	if len(result) != 3 {
 		t.Fatalf("expected 3 PRs, got %d", len(result))
 	}
@@ -761,7 +1079,7 @@ func TestClassifyAll_SortByDate(t *testing.T) {
 		makePR(withAuthor("bob"), withLastCommit(recent), withURL("https://github.com/org/repo/pull/2")),
 		makePR(withAuthor("carol"), withLastCommit(mid), withURL("https://github.com/org/repo/pull/3")),
 	}
-	result := classifyAll(prs, "me", nil, nil, SortDate)
+	result := classifyAll(prs, "me", nil, nil, nil, SortDate, Config{})
 	if len(result) != 3 {
 		t.Fatalf("expected 3 PRs, got %d", len(result))
 	}
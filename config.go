@@ -0,0 +1,109 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Config holds user settings read from a JSON file. Every field is
+// optional; a missing file is the same as an empty one.
+type Config struct {
+	Rules         []SortRule         `json:"rules"`
+	SLA           SLAConfig          `json:"sla"`
+	Calendar      Calendar           `json:"calendar"`
+	Sizes         SizeConfig         `json:"sizes"`
+	Notifications NotificationConfig `json:"notifications"`
+	// Tabs replaces the TUI's default tabs.
+	Tabs []TabConfig `json:"tabs"`
+	// Templates replaces the comments c offers.
+	Templates []CommentTemplate `json:"templates"`
+	// Bot is the review bot whose requests are tracked.
+	Bot BotConfig `json:"bot"`
+	// Dismiss sets when dismissed PRs come back.
+	Dismiss DismissConfig `json:"dismiss"`
+}
+
+// NotificationConfig sets how pr-patrol treats GitHub notifications.
+type NotificationConfig struct {
+	// MarkReadOnOpen marks a PR's notification thread read when it's
+	// opened with enter.
+	MarkReadOnOpen bool `json:"markReadOnOpen"`
+}
+
+// defaultConfigPath returns $XDG_CONFIG_HOME/pr-patrol/config.json (or the
+// platform equivalent).
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "pr-patrol", "config.json")
+}
+
+func parseConfig(data []byte) (Config, error) {
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return Config{}, fmt.Errorf("parsing config: %w", err)
+	}
+	for i := range cfg.Rules {
+		if err := cfg.Rules[i].compile(); err != nil {
+			return Config{}, fmt.Errorf("rule %d (%s): %w", i+1, cfg.Rules[i].Name, err)
+		}
+	}
+	if err := cfg.SLA.compile(); err != nil {
+		return Config{}, fmt.Errorf("sla: %w", err)
+	}
+	if err := cfg.Calendar.compile(); err != nil {
+		return Config{}, fmt.Errorf("calendar: %w", err)
+	}
+	if err := cfg.Sizes.validate(); err != nil {
+		return Config{}, fmt.Errorf("sizes: %w", err)
+	}
+	if len(cfg.Tabs) > maxTabs {
+		return Config{}, fmt.Errorf("tabs: at most %d tabs, got %d", maxTabs, len(cfg.Tabs))
+	}
+	for i := range cfg.Tabs {
+		if err := cfg.Tabs[i].compile(); err != nil {
+			return Config{}, fmt.Errorf("tab %d (%s): %w", i+1, cfg.Tabs[i].Name, err)
+		}
+	}
+	if len(cfg.Templates) > 9 {
+		return Config{}, fmt.Errorf("templates: at most 9 templates, got %d", len(cfg.Templates))
+	}
+	for i := range cfg.Templates {
+		if err := cfg.Templates[i].compile(); err != nil {
+			return Config{}, fmt.Errorf("template %d (%s): %w", i+1, cfg.Templates[i].Name, err)
+		}
+	}
+	if err := cfg.Bot.compile(); err != nil {
+		return Config{}, fmt.Errorf("bot: %w", err)
+	}
+	if err := cfg.Dismiss.compile(); err != nil {
+		return Config{}, fmt.Errorf("dismiss: %w", err)
+	}
+	return cfg, nil
+}
+
+// loadConfig reads the config at path. A missing file at the default path
+// is not an error; a missing file the user named explicitly is.
+func loadConfig(path string, explicit bool) (Config, error) {
+	if path == "" {
+		return Config{}, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !explicit && errors.Is(err, fs.ErrNotExist) {
+			return Config{}, nil
+		}
+		return Config{}, fmt.Errorf("reading config: %w", err)
+	}
+	cfg, err := parseConfig(data)
+	if err != nil {
+		return Config{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return cfg, nil
+}
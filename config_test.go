@@ -0,0 +1,122 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestParseConfig(t *testing.T) {
+	data := `{
+		"rules": [
+			{"name": "old", "match": {"olderThan": "3d"}, "score": -1},
+			{"name": "hotfix", "match": {"labels": ["hotfix"]}, "bucket": -1}
+		]
+	}`
+	cfg, err := parseConfig([]byte(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.Rules) != 2 {
+		t.Fatalf("expected 2 rules, got %d", len(cfg.Rules))
+	}
+	if cfg.Rules[0].Match.olderThan != 72*time.Hour {
+		t.Errorf("expected olderThan compiled to 72h, got %v", cfg.Rules[0].Match.olderThan)
+	}
+	if cfg.Rules[1].Bucket == nil || *cfg.Rules[1].Bucket != -1 {
+		t.Errorf("expected bucket -1, got %v", cfg.Rules[1].Bucket)
+	}
+}
+
+func TestParseConfig_InvalidAge(t *testing.T) {
+	_, err := parseConfig([]byte(`{"rules": [{"name": "bad", "match": {"olderThan": "soon"}}]}`))
+	if err == nil {
+		t.Fatal("expected error for invalid olderThan")
+	}
+}
+
+func TestLoadConfig_Missing(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if _, err := loadConfig(path, false); err != nil {
+		t.Fatalf("expected missing default config to be ignored, got %v", err)
+	}
+	if _, err := loadConfig(path, true); err == nil {
+		t.Fatal("expected error for missing explicit config")
+	}
+}
+
+func TestLoadConfig_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"rules": [{"name": "x", "score": 1}]}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := loadConfig(path, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.Rules) != 1 || cfg.Rules[0].Name != "x" {
+		t.Fatalf("unexpected rules %+v", cfg.Rules)
+	}
+}
+
+func TestParseConfig_SLA(t *testing.T) {
+	data := `{"sla": {"warn": "1d", "breach": "2d", "repos": {"billing": {"breach": "4h"}}, "labels": {"hotfix": {"breach": "1h"}}}}`
+	cfg, err := parseConfig([]byte(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.SLA.breach != 48*time.Hour || cfg.SLA.Repos["billing"].breach != 4*time.Hour || cfg.SLA.Labels["hotfix"].breach != time.Hour {
+		t.Errorf("thresholds not compiled: %+v", cfg.SLA)
+	}
+	if _, err := parseConfig([]byte(`{"sla": {"warn": "later"}}`)); err == nil {
+		t.Error("expected error for invalid SLA threshold")
+	}
+}
+
+func TestParseConfig_Tabs(t *testing.T) {
+	data := `{"tabs": [{"name": "Urgent", "sort": "date", "match": {"labels": ["hotfix"], "olderThan": "1d"}}, {"name": "Mine", "mine": true}]}`
+	cfg, err := parseConfig([]byte(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.Tabs) != 2 || cfg.Tabs[0].sortMode != SortDate || cfg.Tabs[0].Match.olderThan != 24*time.Hour || !cfg.Tabs[1].Mine {
+		t.Errorf("tabs not compiled: %+v", cfg.Tabs)
+	}
+	for _, bad := range []string{
+		`{"tabs": [{"sort": "date"}]}`,
+		`{"tabs": [{"name": "x", "sort": "random"}]}`,
+		`{"tabs": [{"name": "x", "match": {"olderThan": "soon"}}]}`,
+		`{"tabs": [{"name": "1"}, {"name": "2"}, {"name": "3"}, {"name": "4"}, {"name": "5"}, {"name": "6"}, {"name": "7"}, {"name": "8"}, {"name": "9"}, {"name": "10"}]}`,
+	} {
+		if _, err := parseConfig([]byte(bad)); err == nil {
+			t.Errorf("expected error for %s", bad)
+		}
+	}
+}
+
+func TestParseConfig_Bot(t *testing.T) {
+	cfg, err := parseConfig([]byte(`{"bot": {"trigger": "/review", "logins": ["review-bot"]}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Bot.trigger == nil || !cfg.Bot.triggers("/review please") || cfg.Bot.triggers("@claude") {
+		t.Errorf("trigger not compiled: %+v", cfg.Bot)
+	}
+	if _, err := parseConfig([]byte(`{"bot": {"trigger": "  "}}`)); err == nil {
+		t.Error("expected error for blank trigger")
+	}
+}
+
+func TestParseConfig_Dismiss(t *testing.T) {
+	cfg, err := parseConfig([]byte(`{"dismiss": {"expire": "7d", "untilActivity": true}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Dismiss.expire != 7*24*time.Hour || !cfg.Dismiss.UntilActivity {
+		t.Errorf("dismiss not compiled: %+v", cfg.Dismiss)
+	}
+	if _, err := parseConfig([]byte(`{"dismiss": {"expire": "soon"}}`)); err == nil {
+		t.Error("expected error for invalid expire")
+	}
+}
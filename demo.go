@@ -2,18 +2,18 @@ package main
 
 func demoData() []ClassifiedPR {
 	return []ClassifiedPR{
-		{MyReview: MyCommented, OthReview: OthApproved, Activity: ActMine, RepoName: "billing-svc", Number: 342, Author: "samantha", Title: "Add invoice PDF generation endpoint"},
-		{MyReview: MyNone, OthReview: OthMixed, Activity: ActOthers, RepoName: "web-app", Number: 891, Author: "danielk", Title: "Fix timezone handling in scheduler"},
-		{MyReview: MyApprovedStale, OthReview: OthNone, Activity: ActMineStale, RepoName: "billing-svc", Number: 339, Author: "rchen", Title: "Update Stripe webhook handler for new API version"},
-		{MyReview: MyApproved, OthReview: OthApproved, Activity: ActOthersStale, RepoName: "auth-svc", Number: 156, Author: "rchen", Title: "Add SAML SSO support for enterprise accounts"},
-		{MyReview: MyChanges, OthReview: OthMixed, Activity: ActNone, RepoName: "billing-svc", Number: 337, Author: "mlopez", Title: "Refactor subscription tier logic"},
-		{MyReview: MyNone, OthReview: OthNone, Activity: ActNone, RepoName: "web-app", Number: 885, Author: "jpark", Title: "Dark mode toggle in user preferences"},
-		{MyReview: MyNone, OthReview: OthNone, Activity: ActOthers, RepoName: "deploy-tools", Number: 78, Author: "danielk", Title: "Add canary deployment support to rollout script"},
-		{MyReview: MyApproved, OthReview: OthChanges, Activity: ActMine, RepoName: "api-gateway", Number: 214, Author: "samantha", Title: "Rate limiting per API key"},
-		{MyReview: MyNone, OthReview: OthApproved, Activity: ActNone, RepoName: "web-app", Number: 882, Author: "mlopez", Title: "Migrate user settings page to React 19"},
-		{MyReview: MyChangesStale, OthReview: OthNone, Activity: ActMine, RepoName: "auth-svc", Number: 153, Author: "jpark", Title: "Fix session expiry race condition"},
-		{MyReview: MyNone, OthReview: OthNone, Activity: ActNone, RepoName: "data-pipeline", Number: 45, Author: "rchen", Title: "Add retry logic for failed ETL jobs"},
-		{MyReview: MyCommentedStale, OthReview: OthNone, Activity: ActOthersStale, RepoName: "web-app", Number: 878, Author: "danielk", Title: "Accessibility improvements for nav components"},
-		{MyReview: MyNone, OthReview: OthNone, Activity: ActNone, IsDraft: true, RepoName: "web-app", Number: 893, Author: "jpark", Title: "WIP: Notification preferences page"},
+		{MyReview: MyCommented, OthReview: OthApproved, Activity: ActMine, Size: SizeM, RepoName: "billing-svc", Number: 342, Author: "samantha", Title: "Add invoice PDF generation endpoint"},
+		{MyReview: MyNone, OthReview: OthMixed, Activity: ActOthers, Size: SizeS, RepoName: "web-app", Number: 891, Author: "danielk", Title: "Fix timezone handling in scheduler"},
+		{MyReview: MyApprovedStale, OthReview: OthNone, Activity: ActMineStale, Size: SizeL, RepoName: "billing-svc", Number: 339, Author: "rchen", Title: "Update Stripe webhook handler for new API version"},
+		{MyReview: MyApproved, OthReview: OthApproved, Activity: ActOthersStale, Size: SizeXL, RepoName: "auth-svc", Number: 156, Author: "rchen", Title: "Add SAML SSO support for enterprise accounts"},
+		{MyReview: MyChanges, OthReview: OthMixed, Activity: ActNone, Size: SizeM, RepoName: "billing-svc", Number: 337, Author: "mlopez", Title: "Refactor subscription tier logic"},
+		{MyReview: MyNone, OthReview: OthNone, Activity: ActNone, Size: SizeXS, RepoName: "web-app", Number: 885, Author: "jpark", Title: "Dark mode toggle in user preferences"},
+		{MyReview: MyNone, OthReview: OthNone, Activity: ActOthers, Size: SizeS, RepoName: "deploy-tools", Number: 78, Author: "danielk", Title: "Add canary deployment support to rollout script"},
+		{MyReview: MyApproved, OthReview: OthChanges, Activity: ActMine, Size: SizeM, RepoName: "api-gateway", Number: 214, Author: "samantha", Title: "Rate limiting per API key"},
+		{MyReview: MyNone, OthReview: OthApproved, Activity: ActNone, Size: SizeL, RepoName: "web-app", Number: 882, Author: "mlopez", Title: "Migrate user settings page to React 19"},
+		{MyReview: MyChangesStale, OthReview: OthNone, Activity: ActMine, Size: SizeS, RepoName: "auth-svc", Number: 153, Author: "jpark", Title: "Fix session expiry race condition"},
+		{MyReview: MyNone, OthReview: OthNone, Activity: ActNone, Size: SizeXS, RepoName: "data-pipeline", Number: 45, Author: "rchen", Title: "Add retry logic for failed ETL jobs"},
+		{MyReview: MyCommentedStale, OthReview: OthNone, Activity: ActOthersStale, Size: SizeM, RepoName: "web-app", Number: 878, Author: "danielk", Title: "Accessibility improvements for nav components"},
+		{MyReview: MyNone, OthReview: OthNone, Activity: ActNone, IsDraft: true, Size: SizeL, RepoName: "web-app", Number: 893, Author: "jpark", Title: "WIP: Notification preferences page"},
 	}
 }
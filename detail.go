@@ -0,0 +1,415 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// PRDetail is what the detail pane shows beyond the list row, fetched
+// when the pane first opens on a PR.
+type PRDetail struct {
+	Body      string
+	BaseRef   string
+	HeadRef   string
+	Labels    []string
+	Reviewers []ReviewerState
+	Checks    []CheckResult
+	Timeline  []TimelineEvent
+}
+
+// ReviewerState is one reviewer's latest review, or "requested" while
+// their review is outstanding.
+type ReviewerState struct {
+	Name  string
+	State string
+}
+
+// CheckResult is one check run or status context on the head commit.
+// Result is the conclusion once it's done, else the run's status.
+type CheckResult struct {
+	Name   string
+	Result string
+}
+
+// TimelineEvent is one review, comment or push.
+type TimelineEvent struct {
+	At   time.Time
+	Who  string
+	What string
+}
+
+// detailEntry caches one PR's detail, or the error fetching it.
+type detailEntry struct {
+	detail  PRDetail
+	err     error
+	loading bool
+}
+
+func buildDetail(n prDetailNode, org string) PRDetail {
+	d := PRDetail{Body: n.Body, BaseRef: n.BaseRefName, HeadRef: n.HeadRefName}
+	for _, l := range n.Labels.Nodes {
+		d.Labels = append(d.Labels, l.Name)
+	}
+
+	// Outstanding requests first, then everyone else's latest review
+	requested := make(map[string]bool)
+	for _, rr := range n.ReviewRequests.Nodes {
+		name := rr.RequestedReviewer.Login
+		if name == "" && rr.RequestedReviewer.Slug != "" {
+			name = "@" + org + "/" + rr.RequestedReviewer.Slug
+		}
+		if name != "" {
+			requested[name] = true
+			d.Reviewers = append(d.Reviewers, ReviewerState{Name: name, State: "requested"})
+		}
+	}
+	var reviewed []ReviewerState
+	for _, r := range n.LatestReviews.Nodes {
+		if r.Author.Login == "" || requested[r.Author.Login] || r.State == "PENDING" {
+			continue
+		}
+		reviewed = append(reviewed, ReviewerState{Name: r.Author.Login, State: reviewStateText(r.State)})
+	}
+	sort.Slice(reviewed, func(i, j int) bool { return reviewed[i].Name < reviewed[j].Name })
+	d.Reviewers = append(d.Reviewers, reviewed...)
+
+	if len(n.Commits.Nodes) > 0 && n.Commits.Nodes[0].Commit.StatusCheckRollup != nil {
+		for _, c := range n.Commits.Nodes[0].Commit.StatusCheckRollup.Contexts.Nodes {
+			switch c.Typename {
+			case "CheckRun":
+				result := c.Conclusion
+				if c.Status != "COMPLETED" || result == "" {
+					result = c.Status
+				}
+				d.Checks = append(d.Checks, CheckResult{Name: c.Name, Result: strings.ToLower(result)})
+			case "StatusContext":
+				d.Checks = append(d.Checks, CheckResult{Name: c.Context, Result: strings.ToLower(c.State)})
+			}
+		}
+	}
+
+	for _, t := range n.TimelineItems.Nodes {
+		var ev TimelineEvent
+		switch t.Typename {
+		case "PullRequestReview":
+			if t.State == "PENDING" {
+				continue
+			}
+			ev = TimelineEvent{At: t.SubmittedAt, What: "reviewed: " + reviewStateText(t.State)}
+			if ev.At.IsZero() {
+				ev.At = t.CreatedAt
+			}
+			if first := firstLine(t.Body); first != "" {
+				ev.What += " — " + first
+			}
+		case "IssueComment":
+			ev = TimelineEvent{At: t.CreatedAt, What: "commented: " + firstLine(t.Body)}
+		case "PullRequestCommit":
+			if t.Commit == nil {
+				continue
+			}
+			ev = TimelineEvent{At: t.Commit.CommittedDate, What: fmt.Sprintf("pushed %.7s %s", t.Commit.OID, t.Commit.MessageHeadline)}
+			if u := t.Commit.Author.User; u != nil {
+				ev.Who = u.Login
+			}
+		case "HeadRefForcePushedEvent":
+			ev = TimelineEvent{At: t.CreatedAt, What: "force-pushed"}
+			if t.Actor != nil {
+				ev.Who = t.Actor.Login
+			}
+		default:
+			continue
+		}
+		if t.Author != nil {
+			ev.Who = t.Author.Login
+		}
+		d.Timeline = append(d.Timeline, ev)
+	}
+	sort.SliceStable(d.Timeline, func(i, j int) bool { return d.Timeline[i].At.Before(d.Timeline[j].At) })
+	return d
+}
+
+func reviewStateText(state string) string {
+	switch state {
+	case "APPROVED":
+		return "approved"
+	case "CHANGES_REQUESTED":
+		return "changes requested"
+	case "COMMENTED":
+		return "commented"
+	case "DISMISSED":
+		return "dismissed"
+	}
+	return strings.ToLower(state)
+}
+
+// firstLine returns the first non-blank line of s, trimmed.
+func firstLine(s string) string {
+	for _, l := range strings.Split(s, "\n") {
+		if l = strings.TrimSpace(l); l != "" {
+			return l
+		}
+	}
+	return ""
+}
+
+var (
+	mdComment = regexp.MustCompile(`(?s)<!--.*?-->`)
+	mdHeading = regexp.MustCompile(`^#{1,6}\s+`)
+	mdBullet  = regexp.MustCompile(`^(\s*)[-*+]\s+`)
+	mdTask    = regexp.MustCompile(`^(\s*)[-*+]\s+\[([ xX])\]\s+`)
+	mdLink    = regexp.MustCompile(`\[([^\]]+)\]\(([^)]+)\)`)
+	mdImage   = regexp.MustCompile(`!\[([^\]]*)\]\([^)]+\)`)
+	mdEmph    = regexp.MustCompile(`(\*\*|__)(.+?)(\*\*|__)`)
+)
+
+// renderMarkdown turns a PR description into plain terminal lines of at
+// most width columns: comments dropped, headings and list markers
+// simplified, links shown with their targets, code blocks kept as is.
+func renderMarkdown(body string, width int) []string {
+	body = mdComment.ReplaceAllString(strings.ReplaceAll(body, "\r\n", "\n"), "")
+	var out []string
+	inCode := false
+	blank := true
+	for _, line := range strings.Split(body, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCode = !inCode
+			continue
+		}
+		if inCode {
+			out = append(out, "    "+line)
+			blank = false
+			continue
+		}
+		line = strings.TrimRight(line, " \t")
+		if line == "" {
+			if !blank {
+				out = append(out, "")
+			}
+			blank = true
+			continue
+		}
+		blank = false
+
+		indent := ""
+		switch {
+		case mdHeading.MatchString(line):
+			line = strings.ToUpper(mdHeading.ReplaceAllString(line, ""))
+		case mdTask.MatchString(line):
+			m := mdTask.FindStringSubmatch(line)
+			box := "☐ "
+			if m[2] != " " {
+				box = "☑ "
+			}
+			indent = m[1] + "  "
+			line = m[1] + box + line[len(m[0]):]
+		case mdBullet.MatchString(line):
+			m := mdBullet.FindStringSubmatch(line)
+			indent = m[1] + "  "
+			line = m[1] + "• " + line[len(m[0]):]
+		}
+		line = mdImage.ReplaceAllString(line, "[image: $1]")
+		line = mdLink.ReplaceAllString(line, "$1 ($2)")
+		line = mdEmph.ReplaceAllString(line, "$2")
+		line = strings.ReplaceAll(line, "`", "")
+		out = append(out, wrapText(line, width, indent)...)
+	}
+	for len(out) > 0 && out[len(out)-1] == "" {
+		out = out[:len(out)-1]
+	}
+	return out
+}
+
+// wrapText wraps s at word boundaries to width columns, starting
+// continuation lines with indent. Words longer than width are left whole.
+func wrapText(s string, width int, indent string) []string {
+	if width <= 0 || len([]rune(s)) <= width {
+		return []string{s}
+	}
+	lead := s[:len(s)-len(strings.TrimLeft(s, " "))]
+	var lines []string
+	line, lineLen, empty := lead, len(lead), true
+	for _, word := range strings.Fields(s) {
+		n := len([]rune(word))
+		if !empty && lineLen+1+n > width {
+			lines = append(lines, line)
+			line, lineLen, empty = indent, len(indent), true
+		}
+		if !empty {
+			line += " "
+			lineLen++
+		}
+		line += word
+		lineLen += n
+		empty = false
+	}
+	return append(lines, line)
+}
+
+// openDetail shows the detail pane for the selected PR, fetching its
+// detail unless it's cached.
+func (m *model) openDetail() tea.Cmd {
+	pr, ok := m.selectedPR()
+	if !ok {
+		return nil
+	}
+	m.showDetail = true
+	m.detailScroll = 0
+	if _, cached := m.details[pr.URL]; cached {
+		return nil
+	}
+	m.details[pr.URL] = &detailEntry{loading: true}
+	return fetchDetailCmd(pr)
+}
+
+// updateDetail handles keys while the detail pane is open: j/k scroll,
+// J/K move to the next or previous PR, enter opens it.
+func (m model) updateDetail(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	pr, _ := m.selectedPR()
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc", "p", "q":
+		m.showDetail = false
+	case "j", "down":
+		if m.detailScroll < len(m.detailLines(pr))-m.detailHeight() {
+			m.detailScroll++
+		}
+	case "k", "up":
+		if m.detailScroll > 0 {
+			m.detailScroll--
+		}
+	case "J", "K":
+		// Group headers in between are skipped
+		rows := m.rows()
+		dir := 1
+		if msg.String() == "K" {
+			dir = -1
+		}
+		for i := m.cursor + dir; i >= 0 && i < len(rows); i += dir {
+			if rows[i].header == "" {
+				m.cursor = i
+				break
+			}
+		}
+		m.markSelectedSeen()
+		return m, m.openDetail()
+	case "enter":
+		_ = openBrowser(pr.URL)
+	}
+	return m, nil
+}
+
+// detailHeight is how many pane lines fit above the help line.
+func (m model) detailHeight() int {
+	if m.height <= 1 {
+		return 1 << 30
+	}
+	return m.height - 1
+}
+
+func (m model) renderDetail(pr ClassifiedPR) string {
+	lines := m.detailLines(pr)
+	start := min(m.detailScroll, max(len(lines)-m.detailHeight(), 0))
+	end := min(start+m.detailHeight(), len(lines))
+	var b strings.Builder
+	for _, l := range lines[start:end] {
+		b.WriteString(l + "\n")
+	}
+	b.WriteString(helpStyle.Render("j/k: scroll  J/K: next/prev PR  enter: open  esc: close"))
+	return b.String()
+}
+
+// detailLines renders the whole pane for pr, before scrolling.
+func (m model) detailLines(pr ClassifiedPR) []string {
+	width := m.width
+	if width <= 0 {
+		width = 100
+	}
+	lines := []string{
+		fmt.Sprintf("%s  %s#%d  %s", formatIndicators(pr, nil), pr.RepoName, pr.Number, pr.Title),
+		helpStyle.Render(fmt.Sprintf("by %s · %s ← %s · opened %s ago", pr.Author, pr.BaseRef, pr.HeadRef, m.formatAge(pr.CreatedAt))),
+	}
+	e := m.details[pr.URL]
+	switch {
+	case e == nil || e.loading:
+		return append(lines, "", "Loading…")
+	case e.err != nil:
+		return append(lines, "", styleRed.Render("Error: "+e.err.Error()))
+	}
+	d := e.detail
+	if len(d.Labels) > 0 {
+		lines = append(lines, helpStyle.Render("labels: "+strings.Join(d.Labels, ", ")))
+	}
+
+	section := func(title string) {
+		lines = append(lines, "", styleCyan.Render(title))
+	}
+	section("Reviewers")
+	if len(d.Reviewers) == 0 {
+		lines = append(lines, helpStyle.Render("  none"))
+	}
+	for _, r := range d.Reviewers {
+		sym, style := reviewerSymbol(r.State)
+		lines = append(lines, fmt.Sprintf("  %s %s  %s", style.Render(sym), r.Name, style.Render(r.State)))
+	}
+	section("Checks")
+	if len(d.Checks) == 0 {
+		lines = append(lines, helpStyle.Render("  none"))
+	}
+	for _, c := range d.Checks {
+		sym, style := checkSymbol(c.Result)
+		lines = append(lines, fmt.Sprintf("  %s %s  %s", style.Render(sym), c.Name, style.Render(c.Result)))
+	}
+	section("Description")
+	body := renderMarkdown(d.Body, width-2)
+	if len(body) == 0 {
+		lines = append(lines, helpStyle.Render("  no description"))
+	}
+	for _, l := range body {
+		lines = append(lines, "  "+l)
+	}
+	section("Timeline")
+	for _, ev := range d.Timeline {
+		l := fmt.Sprintf("  %s  %-12s %s", ev.At.Local().Format("Jan 02 15:04"), ev.Who, ev.What)
+		if r := []rune(l); len(r) > width {
+			l = string(r[:width-1]) + "…"
+		}
+		lines = append(lines, l)
+	}
+	return lines
+}
+
+func reviewerSymbol(state string) (string, lipgloss.Style) {
+	switch state {
+	case "approved":
+		return "✓", styleGreen
+	case "changes requested":
+		return "✗", styleRed
+	case "commented":
+		return "◆", styleYellow
+	case "requested":
+		return "·", styleWhite
+	default:
+		return "·", styleDim
+	}
+}
+
+func checkSymbol(result string) (string, lipgloss.Style) {
+	switch result {
+	case "success":
+		return "✓", styleGreen
+	case "failure", "error", "timed_out", "cancelled", "action_required", "startup_failure":
+		return "✗", styleRed
+	case "neutral", "skipped", "stale":
+		return "·", styleDim
+	default:
+		return "●", styleYellow
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const detailFixture = `{
+  "body": "<!-- template -->\nFixes the thing.",
+  "baseRefName": "main",
+  "headRefName": "fix",
+  "labels": {"nodes": [{"name": "bug"}]},
+  "reviewRequests": {"nodes": [
+    {"requestedReviewer": {"login": "carol"}},
+    {"requestedReviewer": {"slug": "backend"}}
+  ]},
+  "latestReviews": {"nodes": [
+    {"author": {"login": "bob"}, "state": "CHANGES_REQUESTED", "submittedAt": "2025-01-02T10:00:00Z"},
+    {"author": {"login": "carol"}, "state": "COMMENTED", "submittedAt": "2025-01-02T09:00:00Z"},
+    {"author": {"login": "alice"}, "state": "APPROVED", "submittedAt": "2025-01-02T11:00:00Z"}
+  ]},
+  "commits": {"nodes": [{"commit": {"statusCheckRollup": {"contexts": {"nodes": [
+    {"__typename": "CheckRun", "name": "build", "status": "COMPLETED", "conclusion": "SUCCESS"},
+    {"__typename": "CheckRun", "name": "e2e", "status": "IN_PROGRESS", "conclusion": null},
+    {"__typename": "StatusContext", "context": "ci/legacy", "state": "FAILURE"}
+  ]}}}}]},
+  "timelineItems": {"nodes": [
+    {"__typename": "PullRequestCommit", "commit": {"oid": "abcdef1234567", "messageHeadline": "Fix it", "committedDate": "2025-01-02T08:00:00Z", "author": {"user": {"login": "dave"}}}},
+    {"__typename": "IssueComment", "author": {"login": "bob"}, "body": "\nLooks off\nmore", "createdAt": "2025-01-02T12:00:00Z"},
+    {"__typename": "PullRequestReview", "author": {"login": "bob"}, "state": "CHANGES_REQUESTED", "body": "", "submittedAt": "2025-01-02T10:00:00Z"},
+    {"__typename": "HeadRefForcePushedEvent", "actor": {"login": "dave"}, "createdAt": "2025-01-02T13:00:00Z"}
+  ]}
+}`
+
+func TestBuildDetail(t *testing.T) {
+	var n prDetailNode
+	if err := json.Unmarshal([]byte(detailFixture), &n); err != nil {
+		t.Fatal(err)
+	}
+	d := buildDetail(n, "org")
+
+	wantReviewers := []ReviewerState{
+		{"carol", "requested"},
+		{"@org/backend", "requested"},
+		{"alice", "approved"},
+		{"bob", "changes requested"},
+	}
+	if !slices.Equal(d.Reviewers, wantReviewers) {
+		t.Errorf("reviewers = %v, want %v", d.Reviewers, wantReviewers)
+	}
+	wantChecks := []CheckResult{{"build", "success"}, {"e2e", "in_progress"}, {"ci/legacy", "failure"}}
+	if !slices.Equal(d.Checks, wantChecks) {
+		t.Errorf("checks = %v, want %v", d.Checks, wantChecks)
+	}
+	var events []string
+	for _, ev := range d.Timeline {
+		events = append(events, ev.Who+" "+ev.What)
+	}
+	wantEvents := []string{
+		"dave pushed abcdef1 Fix it",
+		"bob reviewed: changes requested",
+		"bob commented: Looks off",
+		"dave force-pushed",
+	}
+	if !slices.Equal(events, wantEvents) {
+		t.Errorf("timeline = %q, want %q", events, wantEvents)
+	}
+	if !slices.Equal(d.Labels, []string{"bug"}) || d.BaseRef != "main" || d.HeadRef != "fix" {
+		t.Errorf("unexpected labels or branches: %+v", d)
+	}
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	body := "<!-- please fill in -->\r\n## Summary\r\n\r\n\r\nAdds **retries** to the [client](https://x.io/c).\n\n" +
+		"- [x] tests\n- [ ] docs\n* plain item\n\n```\nfoo  bar\n```\n"
+	got := renderMarkdown(body, 80)
+	want := []string{
+		"SUMMARY",
+		"",
+		"Adds retries to the client (https://x.io/c).",
+		"",
+		"☑ tests",
+		"☐ docs",
+		"• plain item",
+		"",
+		"    foo  bar",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("renderMarkdown =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestWrapText(t *testing.T) {
+	got := wrapText("• one two three four five", 12, "  ")
+	want := []string{"• one two", "  three four", "  five"}
+	if !slices.Equal(got, want) {
+		t.Errorf("wrapText = %q, want %q", got, want)
+	}
+	if got := wrapText("short", 12, ""); !slices.Equal(got, []string{"short"}) {
+		t.Errorf("expected short text unchanged, got %q", got)
+	}
+}
@@ -0,0 +1,380 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// DismissConfig sets when a PR dismissed with d comes back.
+type DismissConfig struct {
+	// Expire ends a dismissal after this long, e.g. "7d". Empty never
+	// expires.
+	Expire string `json:"expire,omitempty"`
+	// UntilActivity ends a dismissal when the PR gets new commits or
+	// comments, as u always does.
+	UntilActivity bool `json:"untilActivity,omitempty"`
+
+	expire time.Duration
+}
+
+func (c *DismissConfig) compile() error {
+	if c.Expire == "" {
+		return nil
+	}
+	d, err := parseAge(c.Expire)
+	if err != nil {
+		return fmt.Errorf("expire: %w", err)
+	}
+	if d <= 0 {
+		return fmt.Errorf("expire: must be positive, got %q", c.Expire)
+	}
+	c.expire = d
+	return nil
+}
+
+// prDismissal is a hidden PR, with what ends the dismissal and the
+// activity it had when dismissed.
+type prDismissal struct {
+	At            time.Time `json:"at"`
+	Until         time.Time `json:"until,omitzero"`
+	UntilActivity bool      `json:"untilActivity,omitempty"`
+	Commits       int       `json:"commits"`
+	Comments      int       `json:"comments"`
+	// Ref and Title describe the PR on the dismissals screen.
+	Ref   string `json:"ref"`
+	Title string `json:"title"`
+}
+
+// ended reports whether the dismissal no longer hides pr.
+func (d prDismissal) ended(pr ClassifiedPR, now time.Time) bool {
+	if !d.Until.IsZero() && now.After(d.Until) {
+		return true
+	}
+	return d.UntilActivity && (pr.CommitCount > d.Commits || pr.CommentCount > d.Comments)
+}
+
+// dismissStore persists dismissed and snoozed PRs, and dismissed repos and
+// authors, across sessions.
+type dismissStore struct {
+	path    string
+	PRs     map[string]prDismissal `json:"prs"`
+	Repos   map[string]time.Time   `json:"repos"`
+	Authors map[string]time.Time   `json:"authors"`
+	Snoozes map[string]prSnooze    `json:"snoozes"`
+	// Woke holds PRs whose snooze ended and that haven't been seen since,
+	// with when the snooze ended.
+	Woke  map[string]time.Time `json:"woke"`
+	dirty bool
+}
+
+func newDismissStore(path string) *dismissStore {
+	return &dismissStore{
+		path:    path,
+		PRs:     make(map[string]prDismissal),
+		Repos:   make(map[string]time.Time),
+		Authors: make(map[string]time.Time),
+		Snoozes: make(map[string]prSnooze),
+		Woke:    make(map[string]time.Time),
+	}
+}
+
+// loadDismissals reads the store at path. A missing file gives an empty
+// store.
+func loadDismissals(path string) (*dismissStore, error) {
+	s := newDismissStore(path)
+	data, err := readStateFile(path)
+	if err != nil {
+		return s, fmt.Errorf("reading dismissals: %w", err)
+	}
+	if data == nil {
+		return s, nil
+	}
+	if err := json.Unmarshal(data, s); err != nil {
+		return newDismissStore(path), fmt.Errorf("parsing %s: %w", path, err)
+	}
+	// A file with a section missing leaves its map nil
+	if s.PRs == nil {
+		s.PRs = make(map[string]prDismissal)
+	}
+	if s.Repos == nil {
+		s.Repos = make(map[string]time.Time)
+	}
+	if s.Authors == nil {
+		s.Authors = make(map[string]time.Time)
+	}
+	if s.Snoozes == nil {
+		s.Snoozes = make(map[string]prSnooze)
+	}
+	if s.Woke == nil {
+		s.Woke = make(map[string]time.Time)
+	}
+	return s, nil
+}
+
+// save writes the store if anything changed, dropping dismissals that have
+// run out and "snooze ended" markers nobody came back to.
+func (s *dismissStore) save() error {
+	if !s.unsaved() {
+		return nil
+	}
+	now := time.Now()
+	for url, d := range s.PRs {
+		if !d.Until.IsZero() && now.After(d.Until) {
+			delete(s.PRs, url)
+		}
+	}
+	for url, at := range s.Woke {
+		if now.Sub(at) > snoozeEndedRetention {
+			delete(s.Woke, url)
+		}
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeStateFile(s.path, data); err != nil {
+		return fmt.Errorf("saving dismissals: %w", err)
+	}
+	s.dirty = false
+	return nil
+}
+
+// unsaved reports whether the store has changes to write.
+func (s *dismissStore) unsaved() bool {
+	return s != nil && s.dirty && s.path != ""
+}
+
+// dismissPR hides pr until the configured expiry, or until it gets new
+// activity if untilActivity is set.
+func (s *dismissStore) dismissPR(pr ClassifiedPR, c DismissConfig, untilActivity bool) {
+	d := prDismissal{
+		At:            time.Now(),
+		UntilActivity: untilActivity || c.UntilActivity,
+		Commits:       pr.CommitCount,
+		Comments:      pr.CommentCount,
+		Ref:           fmt.Sprintf("%s#%d", pr.RepoName, pr.Number),
+		Title:         pr.Title,
+	}
+	if c.expire > 0 {
+		d.Until = d.At.Add(c.expire)
+	}
+	s.PRs[pr.URL] = d
+	s.dirty = true
+}
+
+func (s *dismissStore) dismissRepo(repo string) {
+	s.Repos[repo] = time.Now()
+	s.dirty = true
+}
+
+func (s *dismissStore) dismissAuthor(login string) {
+	s.Authors[login] = time.Now()
+	s.dirty = true
+}
+
+// hides reports whether pr is dismissed, by itself, its repo or its author,
+// or snoozed. Snoozes only end through wake.
+func (s *dismissStore) hides(pr ClassifiedPR) bool {
+	if _, ok := s.Snoozes[pr.URL]; ok {
+		return true
+	}
+	if _, ok := s.Repos[pr.RepoName]; ok {
+		return true
+	}
+	if _, ok := s.Authors[pr.Author]; ok {
+		return true
+	}
+	d, ok := s.PRs[pr.URL]
+	return ok && !d.ended(pr, time.Now())
+}
+
+// expire drops PR dismissals that have ended, given the PRs' current
+// activity, and returns how many did.
+func (s *dismissStore) expire(items []ClassifiedPR) int {
+	now := time.Now()
+	n := 0
+	for _, pr := range items {
+		if d, ok := s.PRs[pr.URL]; ok && d.ended(pr, now) {
+			delete(s.PRs, pr.URL)
+			s.dirty = true
+			n++
+		}
+	}
+	return n
+}
+
+// clear drops every dismissal and snooze.
+func (s *dismissStore) clear() {
+	if len(s.PRs)+len(s.Repos)+len(s.Authors)+len(s.Snoozes) == 0 {
+		return
+	}
+	clear(s.PRs)
+	clear(s.Repos)
+	clear(s.Authors)
+	clear(s.Snoozes)
+	s.dirty = true
+}
+
+// dismissEntry is a line on the dismissals screen.
+type dismissEntry struct {
+	kind  string // "PR", "snooze", "repo" or "author"
+	key   string // PR URL, repo name or author login
+	label string
+	until string // what ends it
+	at    time.Time
+}
+
+// prLabel describes a dismissed or snoozed PR by ref and title, or by URL
+// if it was stored without them.
+func prLabel(ref, title, url string) string {
+	if label := strings.TrimSpace(ref + "  " + title); label != "" {
+		return label
+	}
+	return url
+}
+
+// name is e's short name for status messages: the PR's ref, or the key.
+func (e dismissEntry) name() string {
+	if f := strings.Fields(e.label); len(f) > 0 {
+		return f[0]
+	}
+	return e.key
+}
+
+// entries lists every dismissal: repos, then authors, then PRs, each
+// newest first, then snoozes, soonest to end first.
+func (s *dismissStore) entries() []dismissEntry {
+	var out []dismissEntry
+	add := func(kind string, m map[string]time.Time) {
+		var part []dismissEntry
+		for k, at := range m {
+			part = append(part, dismissEntry{kind: kind, key: k, label: k, until: "removed", at: at})
+		}
+		slices.SortFunc(part, func(a, b dismissEntry) int { return b.at.Compare(a.at) })
+		out = append(out, part...)
+	}
+	add("repo", s.Repos)
+	add("author", s.Authors)
+
+	var prs []dismissEntry
+	for url, d := range s.PRs {
+		var until []string
+		if !d.Until.IsZero() {
+			until = append(until, d.Until.Local().Format("Mon Jan 2 15:04"))
+		}
+		if d.UntilActivity {
+			until = append(until, "new activity")
+		}
+		if len(until) == 0 {
+			until = []string{"removed"}
+		}
+		prs = append(prs, dismissEntry{kind: "PR", key: url, label: prLabel(d.Ref, d.Title, url), until: strings.Join(until, " or "), at: d.At})
+	}
+	slices.SortFunc(prs, func(a, b dismissEntry) int { return b.at.Compare(a.at) })
+	out = append(out, prs...)
+
+	var snoozes []dismissEntry
+	now := time.Now()
+	for url, z := range s.Snoozes {
+		snoozes = append(snoozes, dismissEntry{kind: "snooze", key: url, label: prLabel(z.Ref, z.Title, url), until: fmtSnoozeUntil(z.Until, now), at: z.Until})
+	}
+	slices.SortFunc(snoozes, func(a, b dismissEntry) int { return a.at.Compare(b.at) })
+	return append(out, snoozes...)
+}
+
+// remove drops the dismissal e stands for.
+func (s *dismissStore) remove(e dismissEntry) {
+	switch e.kind {
+	case "repo":
+		delete(s.Repos, e.key)
+	case "author":
+		delete(s.Authors, e.key)
+	case "snooze":
+		delete(s.Snoozes, e.key)
+	default:
+		delete(s.PRs, e.key)
+	}
+	s.dirty = true
+}
+
+// filterDismissed drops PRs the store hides, for plain output.
+func filterDismissed(prs []ClassifiedPR, s *dismissStore) []ClassifiedPR {
+	var out []ClassifiedPR
+	for _, pr := range prs {
+		if !s.hides(pr) {
+			out = append(out, pr)
+		}
+	}
+	return out
+}
+
+// updateDismissals handles keys on the dismissals screen: j/k move, d or x
+// removes the selected dismissal, esc, q or X closes.
+func (m model) updateDismissals(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	entries := m.dismissals.entries()
+	if m.confirmingClear {
+		m.confirmingClear = false
+		if msg.String() == "C" {
+			m.dismissals.clear()
+			m.dismissCursor = 0
+			m.statusMsg = fmt.Sprintf("Restored %d dismissed or snoozed", len(entries))
+		} else {
+			m.statusMsg = "Cancelled"
+		}
+		return m, nil
+	}
+	switch msg.String() {
+	case "esc", "q", "X":
+		m.showDismissals = false
+	case "j", "down":
+		if m.dismissCursor < len(entries)-1 {
+			m.dismissCursor++
+		}
+	case "k", "up":
+		if m.dismissCursor > 0 {
+			m.dismissCursor--
+		}
+	case "d", "x", "delete", "backspace":
+		if m.dismissCursor < len(entries) {
+			e := entries[m.dismissCursor]
+			m.dismissals.remove(e)
+			m.statusMsg = fmt.Sprintf("Restored %s %s", e.kind, e.name())
+			if m.dismissCursor >= len(entries)-1 {
+				m.dismissCursor = max(len(entries)-2, 0)
+			}
+		}
+	case "C":
+		if len(entries) > 0 {
+			m.confirmingClear = true
+			m.statusMsg = fmt.Sprintf("Restore all %d? Press C to confirm", len(entries))
+		}
+	}
+	return m, nil
+}
+
+func (m model) renderDismissals() string {
+	var b strings.Builder
+	b.WriteString("Dismissed and snoozed — kept across sessions\n\n")
+	entries := m.dismissals.entries()
+	if len(entries) == 0 {
+		b.WriteString("  Nothing dismissed or snoozed.\n")
+	}
+	for i, e := range entries {
+		line := fmt.Sprintf("  %-6s  %s  (until %s)", e.kind, e.label, e.until)
+		if i == m.dismissCursor {
+			line = selBg.Render(line)
+		}
+		b.WriteString(line + "\n")
+	}
+	b.WriteString("\n")
+	if m.statusMsg != "" {
+		b.WriteString(styleCyan.Render(m.statusMsg) + "\n")
+	}
+	b.WriteString(helpStyle.Render("j/k: move  d/x: restore  C: restore all  esc: close"))
+	return b.String()
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDismissStore_Hides(t *testing.T) {
+	s := newDismissStore("")
+	pr := ClassifiedPR{URL: "u1", RepoName: "api", Author: "alice", CommitCount: 2, CommentCount: 1}
+	if s.hides(pr) {
+		t.Fatal("empty store should hide nothing")
+	}
+	s.dismissPR(pr, DismissConfig{}, true)
+	if !s.hides(pr) {
+		t.Fatal("expected dismissed PR hidden")
+	}
+	pr.CommentCount++
+	if s.hides(pr) {
+		t.Error("expected new comment to end an until-activity dismissal")
+	}
+	if n := s.expire([]ClassifiedPR{pr}); n != 1 || len(s.PRs) != 0 {
+		t.Errorf("expected the ended dismissal dropped, got %d, %v", n, s.PRs)
+	}
+
+	s.dismissRepo("web")
+	s.dismissAuthor("bot")
+	if !s.hides(ClassifiedPR{RepoName: "web"}) || !s.hides(ClassifiedPR{Author: "bot"}) {
+		t.Error("expected repo and author dismissals to hide")
+	}
+}
+
+func TestDismissStore_Expire(t *testing.T) {
+	c := DismissConfig{Expire: "2h"}
+	if err := c.compile(); err != nil {
+		t.Fatal(err)
+	}
+	s := newDismissStore("")
+	pr := ClassifiedPR{URL: "u1", RepoName: "api", Number: 3, Title: "Fix"}
+	s.dismissPR(pr, c, false)
+	d := s.PRs["u1"]
+	if d.Until.Sub(d.At) != 2*time.Hour || d.UntilActivity {
+		t.Fatalf("unexpected dismissal %+v", d)
+	}
+	d.Until = time.Now().Add(-time.Minute)
+	s.PRs["u1"] = d
+	if s.hides(pr) {
+		t.Error("expected expired dismissal to stop hiding")
+	}
+
+	for _, bad := range []string{"soon", "-1h"} {
+		if err := (&DismissConfig{Expire: bad}).compile(); err == nil {
+			t.Errorf("expected error for expire %q", bad)
+		}
+	}
+}
+
+func TestDismissStore_SaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pr-patrol", "dismissed.json")
+	s, err := loadDismissals(path)
+	if err != nil {
+		t.Fatalf("missing file should load empty: %v", err)
+	}
+	s.dismissPR(ClassifiedPR{URL: "u1", RepoName: "api", Number: 1, Title: "Keep", CommitCount: 4}, DismissConfig{}, false)
+	s.PRs["old"] = prDismissal{At: time.Now().Add(-48 * time.Hour), Until: time.Now().Add(-time.Hour)}
+	s.dismissRepo("web")
+	if err := s.save(); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := loadDismissals(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.PRs["u1"].Commits != 4 || loaded.PRs["u1"].Ref != "api#1" {
+		t.Errorf("expected u1 restored, got %+v", loaded.PRs["u1"])
+	}
+	if _, ok := loaded.PRs["old"]; ok {
+		t.Error("expected expired dismissal to be pruned")
+	}
+	if _, ok := loaded.Repos["web"]; !ok || loaded.Authors == nil {
+		t.Errorf("expected repo restored and authors initialised, got %+v", loaded)
+	}
+
+	if err := os.WriteFile(path, []byte("{not json"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if s, err := loadDismissals(path); err == nil || s == nil || s.PRs == nil {
+		t.Error("expected an error and a usable empty store for invalid JSON")
+	}
+}
+
+func TestDismissStore_Entries(t *testing.T) {
+	s := newDismissStore("")
+	s.dismissPR(ClassifiedPR{URL: "u1", RepoName: "api", Number: 1, Title: "Fix"}, DismissConfig{}, true)
+	s.dismissAuthor("bot")
+	s.dismissRepo("web")
+	entries := s.entries()
+	if len(entries) != 3 || entries[0].kind != "repo" || entries[1].kind != "author" || entries[2].kind != "PR" {
+		t.Fatalf("expected repos, authors then PRs, got %+v", entries)
+	}
+	if entries[2].label != "api#1  Fix" || entries[2].until != "new activity" {
+		t.Errorf("unexpected PR entry %+v", entries[2])
+	}
+	s.remove(entries[1])
+	if s.hides(ClassifiedPR{Author: "bot"}) {
+		t.Error("expected author dismissal removed")
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// scissors marks the start of the instructions in a file opened in the
+// editor; it and everything below it are dropped, as with git commit
+// messages.
+const scissors = "# ------------------------ >8 ------------------------"
+
+// stripScissors returns the text above the scissors line, trimmed.
+func stripScissors(content string) string {
+	if i := strings.Index(content, scissors); i >= 0 {
+		content = content[:i]
+	}
+	return strings.TrimSpace(content)
+}
+
+// editorCommand returns $VISUAL or $EDITOR (which may carry arguments),
+// falling back to vi.
+func editorCommand(path string) *exec.Cmd {
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	args := strings.Fields(editor)
+	if len(args) == 0 {
+		args = []string{"vi"}
+	}
+	return exec.Command(args[0], append(args[1:], path)...)
+}
+
+// editTextCmd suspends the TUI to edit initial in the editor via a temp
+// file, then reports the text above the scissors line through done.
+func editTextCmd(initial string, done func(text string, err error) tea.Msg) tea.Cmd {
+	f, err := os.CreateTemp("", "pr-patrol-*.md")
+	if err != nil {
+		return func() tea.Msg { return done("", err) }
+	}
+	path := f.Name()
+	_, err = f.WriteString(initial)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(path)
+		return func() tea.Msg { return done("", err) }
+	}
+	return tea.ExecProcess(editorCommand(path), func(err error) tea.Msg {
+		defer os.Remove(path)
+		if err != nil {
+			return done("", err)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return done("", err)
+		}
+		return done(stripScissors(string(data)), nil)
+	})
+}
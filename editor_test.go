@@ -0,0 +1,29 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestStripScissors(t *testing.T) {
+	pr := ClassifiedPR{RepoName: "repo", Number: 7, Title: "Fix it", URL: "https://github.com/org/repo/pull/7"}
+	if got := stripScissors(reviewFileContent(pr, ReviewApprove)); got != "" {
+		t.Errorf("expected untouched file to give an empty body, got %q", got)
+	}
+	content := "## Nits\n\n- rename x\n" + reviewFileContent(pr, ReviewComment)
+	if got, want := stripScissors(content), "## Nits\n\n- rename x"; got != want {
+		t.Errorf("stripScissors = %q, want %q", got, want)
+	}
+}
+
+func TestEditorCommand(t *testing.T) {
+	t.Setenv("VISUAL", "")
+	t.Setenv("EDITOR", "code --wait")
+	if got := editorCommand("/tmp/r.md").Args; !slices.Equal(got, []string{"code", "--wait", "/tmp/r.md"}) {
+		t.Errorf("args = %q", got)
+	}
+	t.Setenv("EDITOR", "")
+	if got := editorCommand("/tmp/r.md").Args; !slices.Equal(got, []string{"vi", "/tmp/r.md"}) {
+		t.Errorf("expected vi fallback, got %q", got)
+	}
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Explanation says, in words, why the classifier chose each indicator for
+// a PR. It backs the TUI's explain view (x) and --plain --explain.
+type Explanation struct {
+	MyReview  string
+	OthReview string
+	Activity  string
+	Status    string
+	Request   string
+	CodeOwner string
+	Threads   string
+	SLA       string
+	Size      string
+	Stack     string
+	Involves  string
+	Bot       string
+}
+
+func fmtTime(t time.Time) string {
+	if t.IsZero() {
+		return "unknown time"
+	}
+	return t.UTC().Format("2006-01-02 15:04 UTC")
+}
+
+// explain works out the PR's explanation from what the classifier
+// recorded when it decided the indicators, and from cfg for the
+// thresholds it applied. It's only worked out when a PR is explained.
+func (pr ClassifiedPR) explain(cfg Config) Explanation {
+	e := Explanation{
+		MyReview:  explainMyReview(pr),
+		OthReview: explainOthReview(pr),
+		Activity:  explainActivity(pr),
+		Status:    explainStatus(pr),
+		Request:   explainRequest(pr),
+		Threads:   fmt.Sprintf("%d unresolved review threads, %d waiting on your reply", pr.UnresolvedThreads, pr.ThreadsAwaitingMe),
+		SLA:       explainSLA(pr, cfg.SLA, cfg.Calendar),
+		Size:      explainSize(pr, cfg.Sizes),
+		Stack:     explainStack(pr),
+		Involves:  explainInvolvement(pr.Involvement),
+		Bot:       explainBot(pr, cfg.Bot),
+	}
+	if len(pr.CodeOwnerVia) > 0 {
+		e.CodeOwner = "codeowner review requested via " + strings.Join(pr.CodeOwnerVia, ", ")
+	} else {
+		e.CodeOwner = "not requested as codeowner"
+	}
+	return e
+}
+
+// myReviewStates is the review state behind each submitted MyReview.
+var myReviewStates = map[MyReviewIndicator]string{
+	MyApproved:       "APPROVED",
+	MyApprovedStale:  "APPROVED",
+	MyChanges:        "CHANGES_REQUESTED",
+	MyChangesStale:   "CHANGES_REQUESTED",
+	MyCommented:      "COMMENTED",
+	MyCommentedStale: "COMMENTED",
+}
+
+// explainMyReview names the review that decided it and which commit time
+// made it stale.
+func explainMyReview(pr ClassifiedPR) string {
+	state := myReviewStates[pr.MyReview]
+	switch {
+	case pr.MyReview == MyPending:
+		return "you have an unsubmitted (PENDING) review"
+	case state == "":
+		return "you have no submitted review"
+	case pr.HeadCommitAt.IsZero():
+		return fmt.Sprintf("your %s review at %s; no head commit date, so not stale",
+			state, fmtTime(pr.MyReviewAt))
+	case isStaleReview(pr.MyReview):
+		return fmt.Sprintf("your %s review at %s is stale: head commit at %s is newer",
+			state, fmtTime(pr.MyReviewAt), fmtTime(pr.HeadCommitAt))
+	}
+	return fmt.Sprintf("your %s review at %s is current: head commit at %s is older",
+		state, fmtTime(pr.MyReviewAt), fmtTime(pr.HeadCommitAt))
+}
+
+// explainOthReview names the reviewers who decided it, then every
+// reviewer's latest review.
+func explainOthReview(pr ClassifiedPR) string {
+	if len(pr.OtherReviews) == 0 {
+		return "no submitted reviews from others"
+	}
+	var changes, notApproved, all []string
+	for _, r := range pr.OtherReviews {
+		all = append(all, r.Login+" "+r.State)
+		switch r.State {
+		case "CHANGES_REQUESTED":
+			changes = append(changes, r.Login)
+		case "APPROVED":
+		default:
+			notApproved = append(notApproved, r.Login+" ("+r.State+")")
+		}
+	}
+	var why string
+	switch pr.OthReview {
+	case OthChanges:
+		why = "changes requested by " + strings.Join(changes, ", ")
+	case OthMixed:
+		why = "mixed: not yet approved by " + strings.Join(notApproved, ", ")
+	default:
+		why = "approved by everyone who reviewed"
+	}
+	return why + "; latest per reviewer: " + strings.Join(all, ", ")
+}
+
+func explainActivity(pr ClassifiedPR) string {
+	// On my own PRs, C is whether there's been feedback since I last pushed
+	if pr.Readiness != "" {
+		switch pr.Activity {
+		case ActNone:
+			return "no comments or reviews"
+		case ActMine:
+			return fmt.Sprintf("comments or reviews since your head commit (%s)", fmtTime(pr.HeadCommitAt))
+		}
+		return fmt.Sprintf("no comments or reviews since your head commit (%s)", fmtTime(pr.HeadCommitAt))
+	}
+	switch pr.Activity {
+	case ActMentioned:
+		return "you or your team were @-mentioned after you last wrote on the PR"
+	case ActNone:
+		return "no comments or reviews"
+	}
+	who := "your"
+	if pr.Activity == ActOthers || pr.Activity == ActOthersStale {
+		who = "others'"
+	}
+	fresh := "after"
+	if pr.Activity == ActMineStale || pr.Activity == ActOthersStale {
+		fresh = "before"
+	}
+	return fmt.Sprintf("%s latest comment or review came %s the head commit (%s)", who, fresh, fmtTime(pr.HeadCommitAt))
+}
+
+func explainStatus(pr ClassifiedPR) string {
+	switch {
+	case pr.Status == StatusConflict:
+		return "merge conflict (mergeable = CONFLICTING)"
+	case pr.CheckState != "":
+		return "head commit check rollup is " + pr.CheckState
+	}
+	return "no status checks on head commit"
+}
+
+func explainRequest(pr ClassifiedPR) string {
+	switch pr.RequestedVia {
+	case RequestDirect:
+		return "review requested from you directly"
+	case RequestTeam, RequestCodeOwnerTeam:
+		s := "review requested from your team(s) " + strings.Join(pr.RequestedTeams, ", ")
+		if pr.TeamSatisfiedBy != "" {
+			s += "; already covered by teammate " + pr.TeamSatisfiedBy
+		}
+		return s
+	}
+	return "no review requested from you or your teams"
+}
+
+// explainLines renders a PR's explanation, including how its sort bucket
+// was reached, as labelled lines.
+func explainLines(pr ClassifiedPR, cfg Config) []string {
+	e := pr.explain(cfg)
+	rows := []struct{ label, text string }{
+		{"I (yours)", e.MyReview},
+		{"O (others)", e.OthReview},
+		{"C (comments)", e.Activity},
+		{"S (status)", e.Status},
+		{"request", e.Request},
+		{"codeowner", e.CodeOwner},
+		{"threads", e.Threads},
+		{"sla", e.SLA},
+		{"size", e.Size},
+		{"stack", e.Stack},
+		{"involves", e.Involves},
+		{"bot", e.Bot},
+		{"sort", strings.Join(pr.PriorityExplain, ", ")},
+	}
+	lines := make([]string, len(rows))
+	for i, r := range rows {
+		lines[i] = fmt.Sprintf("%-13s %s", r.label+":", r.text)
+	}
+	return lines
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// explainOne classifies a single PR for me and explains it.
+func explainOne(pr PRNode, myTeams map[string]bool) Explanation {
+	return classifyAll([]PRNode{pr}, "me", myTeams, nil, nil, SortPriority, Config{})[0].explain(Config{})
+}
+
+func TestExplainPR_MyReviewStale(t *testing.T) {
+	reviewTime := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	commitTime := time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)
+	pr := makePR(withReview("me", "APPROVED", reviewTime), withLastCommit(commitTime))
+	e := explainOne(pr, nil)
+	if !strings.Contains(e.MyReview, "stale") || !strings.Contains(e.MyReview, "2025-01-03 00:00 UTC") {
+		t.Errorf("expected stale explanation naming the commit time, got %q", e.MyReview)
+	}
+}
+
+func TestExplainPR_MyReviewPending(t *testing.T) {
+	pr := makePR(withReview("me", "PENDING", time.Time{}))
+	e := explainOne(pr, nil)
+	if !strings.Contains(e.MyReview, "PENDING") {
+		t.Errorf("expected pending explanation, got %q", e.MyReview)
+	}
+}
+
+func TestExplainPR_OthMixedNamesReviewer(t *testing.T) {
+	at := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	pr := makePR(
+		withReview("alice", "APPROVED", at),
+		withReview("bob", "COMMENTED", at),
+	)
+	e := explainOne(pr, nil)
+	if !strings.Contains(e.OthReview, "mixed: not yet approved by bob (COMMENTED)") {
+		t.Errorf("expected mixed explanation naming bob, got %q", e.OthReview)
+	}
+}
+
+func TestExplainPR_CodeOwnerViaTeam(t *testing.T) {
+	pr := makePR(withReviewRequest("", "owners", true))
+	e := explainOne(pr, map[string]bool{"owners": true})
+	if e.CodeOwner != "codeowner review requested via team owners" {
+		t.Errorf("unexpected codeowner explanation %q", e.CodeOwner)
+	}
+	if !strings.Contains(e.Request, "owners") {
+		t.Errorf("expected request explanation to name the team, got %q", e.Request)
+	}
+}
+
+func TestExplainPR_AuthorActivity(t *testing.T) {
+	at := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	pr := makePR(withAuthor("me"), withLastCommit(at), withCommentAt("bob", at.Add(time.Hour)))
+	c := classifyAllAuthor([]PRNode{pr}, "me", SortPriority, Config{})[0]
+	if c.Activity != ActMine || !strings.HasPrefix(c.explain(Config{}).Activity, "comments or reviews since your head commit") {
+		t.Errorf("expected explanation to match the %s indicator, got %q", c.Activity, c.explain(Config{}).Activity)
+	}
+}
+
+func TestExplainLines_IncludesSort(t *testing.T) {
+	pr := ClassifiedPR{PriorityExplain: []string{"default bucket 8"}}
+	lines := explainLines(pr, Config{})
+	if !strings.HasPrefix(lines[len(lines)-1], "sort:") || !strings.Contains(lines[len(lines)-1], "default bucket 8") {
+		t.Errorf("expected sort line last, got %q", lines[len(lines)-1])
+	}
+}
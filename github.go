@@ -8,6 +8,8 @@ import (
 	"net/http"
 	"os"
 	"regexp"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -17,11 +19,22 @@ const maxRetries = 3
 
 type PRNode struct {
 	Title     string    `json:"title"`
+	Body      string    `json:"body"`
 	URL       string    `json:"url"`
 	Number    int       `json:"number"`
 	IsDraft   bool      `json:"isDraft"`
 	CreatedAt time.Time `json:"createdAt"`
-	Author    struct {
+	// Additions, Deletions and ChangedFiles size up the diff.
+	Additions    int    `json:"additions"`
+	Deletions    int    `json:"deletions"`
+	ChangedFiles int    `json:"changedFiles"`
+	BaseRefName  string `json:"baseRefName"`
+	HeadRefName  string `json:"headRefName"`
+	HeadRefOid   string `json:"headRefOid"`
+	// IsCrossRepository is set for PRs from a fork, whose head branch
+	// isn't a branch of the repo.
+	IsCrossRepository bool `json:"isCrossRepository"`
+	Author            struct {
 		Login string `json:"login"`
 	} `json:"author"`
 	Repository struct {
@@ -29,19 +42,46 @@ type PRNode struct {
 		NameWithOwner string `json:"nameWithOwner"`
 	} `json:"repository"`
 	Reviews struct {
-		Nodes []ReviewNode `json:"nodes"`
+		TotalCount int          `json:"totalCount"`
+		Nodes      []ReviewNode `json:"nodes"`
 	} `json:"reviews"`
 	Comments struct {
-		Nodes []CommentNode `json:"nodes"`
+		TotalCount int           `json:"totalCount"`
+		Nodes      []CommentNode `json:"nodes"`
 	} `json:"comments"`
 	Mergeable      string `json:"mergeable"`
 	ReviewDecision string `json:"reviewDecision"`
 	Commits        struct {
-		Nodes []CommitNode `json:"nodes"`
+		TotalCount int          `json:"totalCount"`
+		Nodes      []CommitNode `json:"nodes"`
 	} `json:"commits"`
+	Assignees struct {
+		Nodes []struct {
+			Login string `json:"login"`
+		} `json:"nodes"`
+	} `json:"assignees"`
 	ReviewRequests struct {
 		Nodes []ReviewRequestNode `json:"nodes"`
 	} `json:"reviewRequests"`
+	ReviewThreads struct {
+		Nodes []ReviewThreadNode `json:"nodes"`
+	} `json:"reviewThreads"`
+	Labels struct {
+		Nodes []struct {
+			Name string `json:"name"`
+		} `json:"nodes"`
+	} `json:"labels"`
+	TimelineItems struct {
+		Nodes []ReviewRequestedEventNode `json:"nodes"`
+	} `json:"timelineItems"`
+}
+
+type ReviewRequestedEventNode struct {
+	CreatedAt         time.Time `json:"createdAt"`
+	RequestedReviewer struct {
+		Login string `json:"login"`
+		Slug  string `json:"slug"`
+	} `json:"requestedReviewer"`
 }
 
 type ReviewNode struct {
@@ -49,13 +89,19 @@ type ReviewNode struct {
 		Login string `json:"login"`
 	} `json:"author"`
 	State       string    `json:"state"`
+	Body        string    `json:"body"`
 	SubmittedAt time.Time `json:"submittedAt"`
+	// Commit is the head commit the review was made against.
+	Commit *struct {
+		OID string `json:"oid"`
+	} `json:"commit"`
 }
 
 type CommentNode struct {
 	Author struct {
 		Login string `json:"login"`
 	} `json:"author"`
+	Body      string    `json:"body"`
 	CreatedAt time.Time `json:"createdAt"`
 }
 
@@ -76,6 +122,19 @@ type ReviewRequestNode struct {
 	} `json:"requestedReviewer"`
 }
 
+// ReviewThreadNode is a code review thread. Comments says who wrote in it
+// and when, without bodies; Recent is its latest few comments with their
+// bodies, so a page carries 5 bodies per thread rather than 50.
+type ReviewThreadNode struct {
+	IsResolved bool `json:"isResolved"`
+	Comments   struct {
+		Nodes []CommentNode `json:"nodes"`
+	} `json:"comments"`
+	Recent struct {
+		Nodes []CommentNode `json:"nodes"`
+	} `json:"recent"`
+}
+
 type searchResult struct {
 	Data struct {
 		Search struct {
@@ -100,28 +159,43 @@ const graphQLQuery = `query($searchQuery: String!, $cursor: String) {
     nodes {
       ... on PullRequest {
         title
+        body
         url
         number
         createdAt
         isDraft
+        additions
+        deletions
+        changedFiles
+        baseRefName
+        headRefName
+        headRefOid
+        isCrossRepository
         mergeable
         reviewDecision
         author { login }
         repository { name nameWithOwner }
+        labels(first: 20) { nodes { name } }
         reviews(last: 100) {
+          totalCount
           nodes {
             author { login }
             state
+            body
             submittedAt
+            commit { oid }
           }
         }
         comments(last: 100) {
+          totalCount
           nodes {
             author { login }
+            body
             createdAt
           }
         }
         commits(last: 1) {
+          totalCount
           nodes {
             commit {
               committedDate
@@ -129,6 +203,7 @@ const graphQLQuery = `query($searchQuery: String!, $cursor: String) {
             }
           }
         }
+        assignees(first: 20) { nodes { login } }
         reviewRequests(first: 100) {
           nodes {
             asCodeOwner
@@ -138,6 +213,35 @@ const graphQLQuery = `query($searchQuery: String!, $cursor: String) {
             }
           }
         }
+        timelineItems(itemTypes: [REVIEW_REQUESTED_EVENT], last: 50) {
+          nodes {
+            ... on ReviewRequestedEvent {
+              createdAt
+              requestedReviewer {
+                ... on User { login }
+                ... on Team { slug }
+              }
+            }
+          }
+        }
+        reviewThreads(first: 100) {
+          nodes {
+            isResolved
+            comments(last: 50) {
+              nodes {
+                author { login }
+                createdAt
+              }
+            }
+            recent: comments(last: 5) {
+              nodes {
+                author { login }
+                body
+                createdAt
+              }
+            }
+          }
+        }
       }
     }
   }
@@ -314,6 +418,78 @@ func fetchUserTeams(org string) (map[string]bool, error) {
 	return parseUserTeams(out, org)
 }
 
+func parseTeamMembers(data []byte) ([]string, error) {
+	var members []struct {
+		Login string `json:"login"`
+	}
+	if err := json.Unmarshal(data, &members); err != nil {
+		return nil, fmt.Errorf("parsing team members response: %w", err)
+	}
+	var result []string
+	for _, m := range members {
+		if m.Login != "" {
+			result = append(result, m.Login)
+		}
+	}
+	return result, nil
+}
+
+// teamMembersCache holds team rosters for the life of the process. They
+// rarely change between refreshes and each team costs a paginated request.
+var teamMembersCache = struct {
+	sync.Mutex
+	members map[string][]string
+}{members: make(map[string][]string)}
+
+func fetchTeamMembers(org, slug string) ([]string, error) {
+	key := org + "/" + slug
+	teamMembersCache.Lock()
+	members, ok := teamMembersCache.members[key]
+	teamMembersCache.Unlock()
+	if ok {
+		return members, nil
+	}
+
+	url := fmt.Sprintf("https://api.github.com/orgs/%s/teams/%s/members?per_page=100", org, slug)
+	out, err := ghRequestPaginated(url)
+	if err != nil {
+		return nil, fmt.Errorf("fetching members of %s: %w", slug, err)
+	}
+	members, err = parseTeamMembers(out)
+	if err != nil {
+		return nil, err
+	}
+
+	teamMembersCache.Lock()
+	teamMembersCache.members[key] = members
+	teamMembersCache.Unlock()
+	return members, nil
+}
+
+// fetchAllTeamMembers resolves the members of each of my teams in parallel.
+// Teams whose roster can't be fetched are left out rather than failing the
+// whole load.
+func fetchAllTeamMembers(org string, teams map[string]bool) map[string][]string {
+	result := make(map[string][]string)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for slug := range teams {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			members, err := fetchTeamMembers(org, slug)
+			if err != nil {
+				return
+			}
+			mu.Lock()
+			result[slug] = members
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	return result
+}
+
 func fetchCurrentUser() (string, error) {
 	out, err := ghRequest("GET", "https://api.github.com/user", nil)
 	if err != nil {
@@ -338,6 +514,301 @@ func postComment(repo string, number int, body string) error {
 	return err
 }
 
+// addLabels adds labels to a PR, keeping the ones it already has.
+func addLabels(repo string, number int, labels []string) error {
+	url := fmt.Sprintf("https://api.github.com/repos/%s/issues/%d/labels", repo, number)
+	payload, _ := json.Marshal(map[string][]string{"labels": labels})
+	_, err := ghRequest("POST", url, bytes.NewReader(payload))
+	return err
+}
+
+// parsePendingReviewID returns the ID of me's PENDING review among a PR's
+// reviews, or 0 if there isn't one.
+func parsePendingReviewID(data []byte, me string) (int64, error) {
+	var reviews []struct {
+		ID    int64  `json:"id"`
+		State string `json:"state"`
+		User  struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	}
+	if err := json.Unmarshal(data, &reviews); err != nil {
+		return 0, fmt.Errorf("parsing reviews response: %w", err)
+	}
+	for _, r := range reviews {
+		if r.State == "PENDING" && r.User.Login == me {
+			return r.ID, nil
+		}
+	}
+	return 0, nil
+}
+
+// submitReview submits a review through the pull request reviews API.
+// GitHub allows one pending review per user and PR and rejects creating
+// another, so if I already have one it's submitted instead, together with
+// the inline comments drafted in it; pending reports whether it was.
+func submitReview(repo string, number int, me string, event ReviewEvent, body string) (pending bool, err error) {
+	url := fmt.Sprintf("https://api.github.com/repos/%s/pulls/%d/reviews", repo, number)
+	out, err := ghRequestPaginated(url + "?per_page=100")
+	if err != nil {
+		return false, fmt.Errorf("fetching reviews: %w", err)
+	}
+	id, err := parsePendingReviewID(out, me)
+	if err != nil {
+		return false, err
+	}
+	if id != 0 {
+		url = fmt.Sprintf("%s/%d/events", url, id)
+	}
+	payload, _ := json.Marshal(map[string]string{"event": string(event), "body": body})
+	_, err = ghRequest("POST", url, bytes.NewReader(payload))
+	return id != 0, err
+}
+
+// Notification is a GitHub notification thread about a PR.
+type Notification struct {
+	ThreadID string
+	Unread   bool
+	Reason   string
+}
+
+var pullAPIURLRE = regexp.MustCompile(`^https://api\.github\.com/repos/([^/]+/[^/]+)/pulls/(\d+)$`)
+
+// parseNotifications maps PR URLs to their notification threads, keeping
+// only PR threads in org's repos.
+func parseNotifications(data []byte, org string) (map[string]Notification, error) {
+	var threads []struct {
+		ID         string `json:"id"`
+		Unread     bool   `json:"unread"`
+		Reason     string `json:"reason"`
+		Repository struct {
+			Owner struct {
+				Login string `json:"login"`
+			} `json:"owner"`
+		} `json:"repository"`
+		Subject struct {
+			Type string `json:"type"`
+			URL  string `json:"url"`
+		} `json:"subject"`
+	}
+	if err := json.Unmarshal(data, &threads); err != nil {
+		return nil, fmt.Errorf("parsing notifications response: %w", err)
+	}
+	result := make(map[string]Notification)
+	for _, t := range threads {
+		if t.Subject.Type != "PullRequest" || !strings.EqualFold(t.Repository.Owner.Login, org) {
+			continue
+		}
+		m := pullAPIURLRE.FindStringSubmatch(t.Subject.URL)
+		if m == nil {
+			continue
+		}
+		result["https://github.com/"+m[1]+"/pull/"+m[2]] = Notification{ThreadID: t.ID, Unread: t.Unread, Reason: t.Reason}
+	}
+	return result, nil
+}
+
+// fetchNotifications returns my unread PR notification threads in org.
+func fetchNotifications(org string) (map[string]Notification, error) {
+	out, err := ghRequestPaginated("https://api.github.com/notifications?per_page=50")
+	if err != nil {
+		return nil, fmt.Errorf("fetching notifications: %w", err)
+	}
+	return parseNotifications(out, org)
+}
+
+// fetchCompareStatus reports how head relates to base in repo: "ahead"
+// when base is an ancestor of head, "diverged" after a force push, etc.
+func fetchCompareStatus(repo, base, head string) (string, error) {
+	url := fmt.Sprintf("https://api.github.com/repos/%s/compare/%s...%s?per_page=1", repo, base, head)
+	out, err := ghRequest("GET", url, nil)
+	if err != nil {
+		return "", fmt.Errorf("comparing %s...%s: %w", base, head, err)
+	}
+	var cmp struct {
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal(out, &cmp); err != nil {
+		return "", fmt.Errorf("parsing compare response: %w", err)
+	}
+	return cmp.Status, nil
+}
+
+// prDetailNode is the extra data the detail pane fetches for one PR.
+type prDetailNode struct {
+	Body        string `json:"body"`
+	BaseRefName string `json:"baseRefName"`
+	HeadRefName string `json:"headRefName"`
+	Labels      struct {
+		Nodes []struct {
+			Name string `json:"name"`
+		} `json:"nodes"`
+	} `json:"labels"`
+	ReviewRequests struct {
+		Nodes []ReviewRequestNode `json:"nodes"`
+	} `json:"reviewRequests"`
+	LatestReviews struct {
+		Nodes []ReviewNode `json:"nodes"`
+	} `json:"latestReviews"`
+	Commits struct {
+		Nodes []struct {
+			Commit struct {
+				StatusCheckRollup *struct {
+					Contexts struct {
+						Nodes []checkContextNode `json:"nodes"`
+					} `json:"contexts"`
+				} `json:"statusCheckRollup"`
+			} `json:"commit"`
+		} `json:"nodes"`
+	} `json:"commits"`
+	TimelineItems struct {
+		Nodes []timelineNode `json:"nodes"`
+	} `json:"timelineItems"`
+}
+
+// checkContextNode is a CheckRun or a legacy StatusContext.
+type checkContextNode struct {
+	Typename   string `json:"__typename"`
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Conclusion string `json:"conclusion"`
+	Context    string `json:"context"`
+	State      string `json:"state"`
+}
+
+// timelineNode is a review, comment, commit or force push.
+type timelineNode struct {
+	Typename string `json:"__typename"`
+	Author   *struct {
+		Login string `json:"login"`
+	} `json:"author"`
+	Actor *struct {
+		Login string `json:"login"`
+	} `json:"actor"`
+	State       string    `json:"state"`
+	Body        string    `json:"body"`
+	CreatedAt   time.Time `json:"createdAt"`
+	SubmittedAt time.Time `json:"submittedAt"`
+	Commit      *struct {
+		OID             string    `json:"oid"`
+		MessageHeadline string    `json:"messageHeadline"`
+		CommittedDate   time.Time `json:"committedDate"`
+		Author          struct {
+			User *struct {
+				Login string `json:"login"`
+			} `json:"user"`
+		} `json:"author"`
+	} `json:"commit"`
+}
+
+const detailQuery = `query($owner: String!, $name: String!, $number: Int!) {
+  repository(owner: $owner, name: $name) {
+    pullRequest(number: $number) {
+      body
+      baseRefName
+      headRefName
+      labels(first: 20) { nodes { name } }
+      reviewRequests(first: 50) {
+        nodes {
+          requestedReviewer {
+            ... on User { login }
+            ... on Team { slug }
+          }
+        }
+      }
+      latestReviews(first: 50) {
+        nodes {
+          author { login }
+          state
+          submittedAt
+        }
+      }
+      commits(last: 1) {
+        nodes {
+          commit {
+            statusCheckRollup {
+              contexts(first: 100) {
+                nodes {
+                  __typename
+                  ... on CheckRun { name status conclusion }
+                  ... on StatusContext { context state }
+                }
+              }
+            }
+          }
+        }
+      }
+      timelineItems(last: 50, itemTypes: [PULL_REQUEST_REVIEW, ISSUE_COMMENT, PULL_REQUEST_COMMIT, HEAD_REF_FORCE_PUSHED_EVENT]) {
+        nodes {
+          __typename
+          ... on PullRequestReview { author { login } state body submittedAt createdAt }
+          ... on IssueComment { author { login } body createdAt }
+          ... on PullRequestCommit {
+            commit { oid messageHeadline committedDate author { user { login } } }
+          }
+          ... on HeadRefForcePushedEvent { actor { login } createdAt }
+        }
+      }
+    }
+  }
+}`
+
+// fetchPRDetail fetches the detail pane's data for one PR.
+func fetchPRDetail(repo string, number int) (prDetailNode, error) {
+	owner, name, _ := strings.Cut(repo, "/")
+	payload, _ := json.Marshal(map[string]interface{}{
+		"query": detailQuery,
+		"variables": map[string]interface{}{
+			"owner":  owner,
+			"name":   name,
+			"number": number,
+		},
+	})
+	out, err := ghRequest("POST", "https://api.github.com/graphql", bytes.NewReader(payload))
+	if err != nil {
+		return prDetailNode{}, fmt.Errorf("fetching %s#%d: %w", repo, number, err)
+	}
+	var result struct {
+		Data struct {
+			Repository struct {
+				PullRequest *prDetailNode `json:"pullRequest"`
+			} `json:"repository"`
+		} `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(out, &result); err != nil {
+		return prDetailNode{}, fmt.Errorf("parsing GraphQL response: %w", err)
+	}
+	if len(result.Errors) > 0 {
+		return prDetailNode{}, fmt.Errorf("GraphQL error: %s", result.Errors[0].Message)
+	}
+	if result.Data.Repository.PullRequest == nil {
+		return prDetailNode{}, fmt.Errorf("%s#%d not found", repo, number)
+	}
+	return *result.Data.Repository.PullRequest, nil
+}
+
+func markThreadRead(threadID string) error {
+	_, err := ghRequest("PATCH", "https://api.github.com/notifications/threads/"+threadID, nil)
+	return err
+}
+
+func unsubscribeThread(threadID string) error {
+	_, err := ghRequest("DELETE", "https://api.github.com/notifications/threads/"+threadID+"/subscription", nil)
+	return err
+}
+
+// applyNotifications marks PRs that have unread notification threads.
+func applyNotifications(items []ClassifiedPR, notes map[string]Notification) {
+	for i := range items {
+		n, ok := notes[items[i].URL]
+		items[i].NotificationThread = n.ThreadID
+		items[i].Unread = ok && n.Unread
+	}
+}
+
 func fetchOpenPRs(org string, limit int) ([]PRNode, error) {
 	var allPRs []PRNode
 	searchQuery := fmt.Sprintf("is:pr is:open sort:updated org:%s", org)
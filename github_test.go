@@ -229,6 +229,49 @@ func TestParseSearchResult_CommentCreatedAt(t *testing.T) {
 	}
 }
 
+func TestParseSearchResult_ThreadRecentBodies(t *testing.T) {
+	threadJSON := `{
+	  "data": {
+	    "search": {
+	      "pageInfo": { "hasNextPage": false, "endCursor": "x" },
+	      "nodes": [
+	        {
+	          "title": "Test",
+	          "url": "https://github.com/org/repo/pull/51",
+	          "number": 51,
+	          "author": { "login": "alice" },
+	          "repository": { "name": "repo", "nameWithOwner": "org/repo" },
+	          "reviewThreads": { "nodes": [
+	            {
+	              "isResolved": false,
+	              "comments": { "nodes": [
+	                { "author": { "login": "me" }, "createdAt": "2025-01-16T10:00:00Z" },
+	                { "author": { "login": "bob" }, "createdAt": "2025-01-17T10:00:00Z" }
+	              ] },
+	              "recent": { "nodes": [
+	                { "author": { "login": "bob" }, "body": "@me thoughts?", "createdAt": "2025-01-17T10:00:00Z" }
+	              ] }
+	            }
+	          ] }
+	        }
+	      ]
+	    }
+	  }
+	}`
+	var result searchResult
+	if err := json.Unmarshal([]byte(threadJSON), &result); err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	pr := result.Data.Search.Nodes[0]
+	th := pr.ReviewThreads.Nodes[0]
+	if len(th.Comments.Nodes) != 2 || len(th.Recent.Nodes) != 1 || th.Recent.Nodes[0].Body != "@me thoughts?" {
+		t.Fatalf("unexpected thread: %+v", th)
+	}
+	if !hasUnansweredMention(pr, "me", newMentionMatcher("me", nil)) {
+		t.Error("expected the mention in the latest thread comment found")
+	}
+}
+
 func TestParseTeamsResponse(t *testing.T) {
 	teamsJSON := `[
 		{"slug": "backend", "organization": {"login": "myorg"}},
@@ -263,6 +306,42 @@ func TestParseTeamsResponse_Empty(t *testing.T) {
 	}
 }
 
+func TestParseTeamMembers(t *testing.T) {
+	membersJSON := `[{"login": "alice"}, {"login": "bob"}, {"login": ""}]`
+	members, err := parseTeamMembers([]byte(membersJSON))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	if len(members) != 2 || members[0] != "alice" || members[1] != "bob" {
+		t.Fatalf("expected [alice bob], got %v", members)
+	}
+}
+
+func TestParseNotifications(t *testing.T) {
+	data := `[
+		{"id": "11", "unread": true, "reason": "review_requested",
+		 "repository": {"owner": {"login": "MyOrg"}},
+		 "subject": {"type": "PullRequest", "url": "https://api.github.com/repos/myorg/web/pulls/42"}},
+		{"id": "12", "unread": true, "reason": "mention",
+		 "repository": {"owner": {"login": "other"}},
+		 "subject": {"type": "PullRequest", "url": "https://api.github.com/repos/other/web/pulls/1"}},
+		{"id": "13", "unread": true, "reason": "subscribed",
+		 "repository": {"owner": {"login": "myorg"}},
+		 "subject": {"type": "Issue", "url": "https://api.github.com/repos/myorg/web/issues/7"}}
+	]`
+	notes, err := parseNotifications([]byte(data), "myorg")
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	if len(notes) != 1 {
+		t.Fatalf("expected only the org PR thread, got %v", notes)
+	}
+	n, ok := notes["https://github.com/myorg/web/pull/42"]
+	if !ok || n.ThreadID != "11" || !n.Unread || n.Reason != "review_requested" {
+		t.Errorf("unexpected notification: %+v (found %v)", n, ok)
+	}
+}
+
 func TestParseUserResponse(t *testing.T) {
 	userJSON := `{"login": "testuser"}`
 	var user struct {
@@ -275,3 +354,22 @@ func TestParseUserResponse(t *testing.T) {
 		t.Fatalf("expected 'testuser', got %q", user.Login)
 	}
 }
+
+func TestParsePendingReviewID(t *testing.T) {
+	data := `[
+		{"id": 1, "state": "APPROVED", "user": {"login": "me"}},
+		{"id": 2, "state": "PENDING", "user": {"login": "alice"}},
+		{"id": 3, "state": "PENDING", "user": {"login": "me"}}
+	]`
+	id, err := parsePendingReviewID([]byte(data), "me")
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	if id != 3 {
+		t.Errorf("expected my pending review 3, got %d", id)
+	}
+	id, err = parsePendingReviewID([]byte(`[{"id": 1, "state": "COMMENTED", "user": {"login": "me"}}]`), "me")
+	if err != nil || id != 0 {
+		t.Errorf("expected no pending review, got %d (%v)", id, err)
+	}
+}
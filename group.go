@@ -0,0 +1,201 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+)
+
+// GroupBy splits the list into groups under headers.
+type GroupBy string
+
+const (
+	GroupNone     GroupBy = ""
+	GroupRepo     GroupBy = "repo"
+	GroupAuthor   GroupBy = "author"
+	GroupPriority GroupBy = "priority"
+	GroupStatus   GroupBy = "status"
+	GroupTeam     GroupBy = "team"
+)
+
+// groupModes is the order g cycles through.
+var groupModes = []GroupBy{GroupNone, GroupRepo, GroupAuthor, GroupPriority, GroupStatus, GroupTeam}
+
+func parseGroupBy(s string) (GroupBy, error) {
+	if s == "none" {
+		return GroupNone, nil
+	}
+	if g := GroupBy(s); slices.Contains(groupModes, g) {
+		return g, nil
+	}
+	return "", fmt.Errorf("invalid group-by %q: must be none, repo, author, priority, status or team", s)
+}
+
+func (g GroupBy) String() string {
+	if g == GroupNone {
+		return "none"
+	}
+	return string(g)
+}
+
+var statusGroupNames = map[StatusIndicator]string{
+	StatusPass:     "ci passing",
+	StatusFail:     "ci failing",
+	StatusPending:  "ci running",
+	StatusConflict: "conflicts",
+	StatusNone:     "no checks",
+}
+
+// groupKey names the group a PR falls in.
+func groupKey(pr ClassifiedPR, g GroupBy) string {
+	switch g {
+	case GroupRepo:
+		return pr.RepoName
+	case GroupAuthor:
+		return pr.Author
+	case GroupPriority:
+		return fmt.Sprintf("priority %d", pr.Priority)
+	case GroupStatus:
+		if name, ok := statusGroupNames[pr.Status]; ok {
+			return name
+		}
+		return string(pr.Status)
+	case GroupTeam:
+		switch {
+		case pr.RequestedVia == RequestDirect:
+			return "requested from you"
+		case len(pr.RequestedTeams) > 0:
+			return "@" + pr.RequestedTeams[0]
+		}
+		return "no team request"
+	}
+	return ""
+}
+
+// prGroup is a run of PRs sharing a group key.
+type prGroup struct {
+	Key   string
+	Items []ClassifiedPR
+}
+
+// groupPRs splits items into groups, in the order each group's first PR
+// appears, so the sort order decides which group comes first. Items keep
+// their order within a group.
+func groupPRs(items []ClassifiedPR, g GroupBy) []prGroup {
+	var groups []prGroup
+	index := make(map[string]int)
+	for _, pr := range items {
+		key := groupKey(pr, g)
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, prGroup{Key: key})
+		}
+		groups[i].Items = append(groups[i].Items, pr)
+	}
+	return groups
+}
+
+// listRow is one line of the list: a group header, or a PR.
+type listRow struct {
+	header    string // group key; empty for a PR row
+	count     int
+	collapsed bool
+	pr        ClassifiedPR
+}
+
+// rows returns the lines the list shows, which the cursor moves over.
+// Ungrouped, it's one row per PR; grouped, each group has a header and
+// its PRs follow unless it's collapsed.
+func (m model) rows() []listRow {
+	vis := m.visibleIn(m.tab)
+	if m.groupBy == GroupNone {
+		rows := make([]listRow, len(vis))
+		for i, pr := range vis {
+			rows[i] = listRow{pr: pr}
+		}
+		return rows
+	}
+	var rows []listRow
+	for _, g := range groupPRs(vis, m.groupBy) {
+		collapsed := m.collapsed[m.groupBy.String()+"\x00"+g.Key]
+		rows = append(rows, listRow{header: g.Key, count: len(g.Items), collapsed: collapsed})
+		if collapsed {
+			continue
+		}
+		for _, pr := range g.Items {
+			rows = append(rows, listRow{pr: pr})
+		}
+	}
+	return rows
+}
+
+// selectedGroup returns the key of the group under the cursor: its header
+// or one of its PRs.
+func (m model) selectedGroup() (string, bool) {
+	rows := m.rows()
+	if m.groupBy == GroupNone || m.cursor < 0 || m.cursor >= len(rows) {
+		return "", false
+	}
+	if r := rows[m.cursor]; r.header != "" {
+		return r.header, true
+	}
+	return groupKey(rows[m.cursor].pr, m.groupBy), true
+}
+
+// toggleGroup collapses or expands the group under the cursor, leaving
+// the cursor on its header.
+func (m *model) toggleGroup() {
+	key, ok := m.selectedGroup()
+	if !ok {
+		return
+	}
+	k := m.groupBy.String() + "\x00" + key
+	if m.collapsed[k] {
+		delete(m.collapsed, k)
+	} else {
+		m.collapsed[k] = true
+	}
+	for i, r := range m.rows() {
+		if r.header == key {
+			m.cursor = i
+			break
+		}
+	}
+}
+
+// toggleAllGroups collapses every group, or expands them all if they
+// already are.
+func (m *model) toggleAllGroups() {
+	if m.groupBy == GroupNone {
+		return
+	}
+	groups := groupPRs(m.visibleIn(m.tab), m.groupBy)
+	all := true
+	for _, g := range groups {
+		all = all && m.collapsed[m.groupBy.String()+"\x00"+g.Key]
+	}
+	for _, g := range groups {
+		k := m.groupBy.String() + "\x00" + g.Key
+		if all {
+			delete(m.collapsed, k)
+		} else {
+			m.collapsed[k] = true
+		}
+	}
+	m.cursor = 0
+	if all {
+		m.statusMsg = "Expanded all groups"
+	} else {
+		m.statusMsg = "Collapsed all groups"
+	}
+}
+
+// groupHeader renders a group's header line.
+func groupHeader(r listRow) string {
+	arrow := "▾"
+	if r.collapsed {
+		arrow = "▸"
+	}
+	return fmt.Sprintf("%s %s (%d)", arrow, r.header, r.count)
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseGroupBy(t *testing.T) {
+	for s, want := range map[string]GroupBy{"none": GroupNone, "": GroupNone, "repo": GroupRepo, "team": GroupTeam} {
+		if got, err := parseGroupBy(s); err != nil || got != want {
+			t.Errorf("parseGroupBy(%q) = %q, %v; want %q", s, got, err, want)
+		}
+	}
+	if _, err := parseGroupBy("label"); err == nil {
+		t.Error("expected error for unknown group-by")
+	}
+}
+
+func TestGroupPRs(t *testing.T) {
+	items := []ClassifiedPR{
+		{RepoName: "web", Number: 1, Status: StatusFail},
+		{RepoName: "api", Number: 2, Status: StatusPass},
+		{RepoName: "web", Number: 3, Status: StatusFail},
+	}
+	groups := groupPRs(items, GroupRepo)
+	if len(groups) != 2 || groups[0].Key != "web" || groups[1].Key != "api" {
+		t.Fatalf("expected web then api in list order, got %+v", groups)
+	}
+	if n := []int{groups[0].Items[0].Number, groups[0].Items[1].Number}; !slices.Equal(n, []int{1, 3}) {
+		t.Errorf("expected items to keep their order, got %v", n)
+	}
+	if got := groupKey(items[0], GroupStatus); got != "ci failing" {
+		t.Errorf("status group = %q", got)
+	}
+}
+
+func TestGroupKey_Team(t *testing.T) {
+	pr := makePR(withReviewRequest("", "backend", false), withReviewRequest("", "frontend", false))
+	teams := computeRequestedTeams(pr, map[string]bool{"frontend": true})
+	if !slices.Equal(teams, []string{"org/frontend"}) {
+		t.Fatalf("expected only my team, got %v", teams)
+	}
+	if got := groupKey(ClassifiedPR{RequestedTeams: teams}, GroupTeam); got != "@org/frontend" {
+		t.Errorf("team group = %q", got)
+	}
+	if got := groupKey(ClassifiedPR{RequestedVia: RequestDirect, RequestedTeams: teams}, GroupTeam); got != "requested from you" {
+		t.Errorf("direct request group = %q", got)
+	}
+	if got := groupKey(ClassifiedPR{}, GroupTeam); got != "no team request" {
+		t.Errorf("no team group = %q", got)
+	}
+}
+
+func TestRenderPlain_GroupBy(t *testing.T) {
+	items := []ClassifiedPR{
+		{RepoName: "web", Number: 1, Title: "One", Author: "alice"},
+		{RepoName: "api", Number: 2, Title: "Two", Author: "bob"},
+		{RepoName: "web", Number: 3, Title: "Three", Author: "alice"},
+	}
+	var buf bytes.Buffer
+	renderPlainOpts(&buf, items, plainOptions{groupBy: GroupAuthor})
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 6 || lines[0] != "alice (2)" || lines[3] != "" || lines[4] != "bob (1)" {
+		t.Fatalf("unexpected grouped output:\n%s", buf.String())
+	}
+	if !strings.Contains(lines[2], "web#3") || !strings.Contains(lines[5], "api#2") {
+		t.Errorf("PRs not under their headers:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+)
+
+// myReviewOID returns the commit my deciding review was made against.
+func myReviewOID(pr PRNode, me string) string {
+	last, _ := myDecidingReview(pr, me)
+	if last == nil || last.Commit == nil {
+		return ""
+	}
+	return last.Commit.OID
+}
+
+// interdiffURL is the PR's files view limited to the commits pushed since
+// my last review, or "" if there's nothing to compare.
+func interdiffURL(pr ClassifiedPR) string {
+	if pr.MyReviewOID == "" || pr.HeadOID == "" || pr.MyReviewOID == pr.HeadOID {
+		return ""
+	}
+	return fmt.Sprintf("%s/files/%s..%s", pr.URL, pr.MyReviewOID, pr.HeadOID)
+}
+
+// resolveInterdiff checks that my reviewed commit is still an ancestor of
+// the head. After a force push it isn't (or is gone), and the range view
+// would be meaningless, so it falls back to the PR's files view, where
+// GitHub flags files changed since my last review.
+func resolveInterdiff(pr ClassifiedPR) (url string, fallback bool) {
+	url = interdiffURL(pr)
+	if url == "" {
+		return pr.URL + "/files", true
+	}
+	status, err := fetchCompareStatus(pr.RepoFullName, pr.MyReviewOID, pr.HeadOID)
+	if err != nil || status != "ahead" {
+		return pr.URL + "/files", true
+	}
+	return url, false
+}
+
+// interdiffWorkers caps how many compare requests run at once, so a long
+// list of stale reviews doesn't trip GitHub's secondary rate limits.
+const interdiffWorkers = 6
+
+// resolveAllInterdiffs fills in InterdiffURL for every PR with a stale
+// review, checking up to interdiffWorkers of them in parallel.
+func resolveAllInterdiffs(items []ClassifiedPR) {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, interdiffWorkers)
+	for i := range items {
+		if !isStaleReview(items[i].MyReview) {
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() { <-sem; wg.Done() }()
+			items[i].InterdiffURL, _ = resolveInterdiff(items[i])
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func withReviewCommit(oid string) func(*PRNode) {
+	return func(pr *PRNode) {
+		r := &pr.Reviews.Nodes[len(pr.Reviews.Nodes)-1]
+		r.Commit = &struct {
+			OID string `json:"oid"`
+		}{OID: oid}
+	}
+}
+
+func TestMyReviewOID(t *testing.T) {
+	at := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	pr := makePR(
+		withReview("me", "COMMENTED", at), withReviewCommit("aaa"),
+		withReview("me", "APPROVED", at.Add(time.Hour)), withReviewCommit("bbb"),
+		withReview("other", "APPROVED", at.Add(2*time.Hour)), withReviewCommit("ccc"),
+	)
+	if got := myReviewOID(pr, "me"); got != "bbb" {
+		t.Errorf("myReviewOID = %q, want %q", got, "bbb")
+	}
+	if got := myReviewOID(makePR(), "me"); got != "" {
+		t.Errorf("no review: myReviewOID = %q, want empty", got)
+	}
+}
+
+func TestInterdiffURL(t *testing.T) {
+	pr := ClassifiedPR{URL: "https://github.com/org/repo/pull/5", MyReviewOID: "abc", HeadOID: "def"}
+	if got, want := interdiffURL(pr), "https://github.com/org/repo/pull/5/files/abc..def"; got != want {
+		t.Errorf("interdiffURL = %q, want %q", got, want)
+	}
+	pr.HeadOID = "abc"
+	if got := interdiffURL(pr); got != "" {
+		t.Errorf("same commit: interdiffURL = %q, want empty", got)
+	}
+	// Without a recorded commit there's nothing to compare: fall back to files
+	url, fallback := resolveInterdiff(ClassifiedPR{URL: "https://github.com/org/repo/pull/5"})
+	if !fallback || url != "https://github.com/org/repo/pull/5/files" {
+		t.Errorf("resolveInterdiff = %q, %v; want files view fallback", url, fallback)
+	}
+}
+
+func TestRenderPlainInterdiff(t *testing.T) {
+	items := []ClassifiedPR{
+		{RepoName: "r", Number: 1, Author: "a", Title: "t", MyReview: MyApprovedStale, InterdiffURL: "https://github.com/o/r/pull/1/files/a..b"},
+		{RepoName: "r", Number: 2, Author: "a", Title: "t", MyReview: MyApproved},
+	}
+	var buf bytes.Buffer
+	renderPlainOpts(&buf, items, plainOptions{interdiff: true})
+	if got := strings.Count(buf.String(), "since your review: "); got != 1 {
+		t.Errorf("expected one interdiff line, got %d in %q", got, buf.String())
+	}
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Involvement is one way I'm involved in a PR.
+type Involvement string
+
+const (
+	InvRequested   Involvement = "requested"   // my review was requested, directly or via a team
+	InvAssignee    Involvement = "assignee"    // I'm assigned to the PR
+	InvMentioned   Involvement = "mentioned"   // someone @-mentioned me or my team
+	InvParticipant Involvement = "participant" // I commented, reviewed or replied in a thread
+	InvAuthor      Involvement = "author"      // I opened it
+)
+
+// InvolvementFilter narrows the list to PRs with a given involvement.
+// InvolveAny keeps PRs with any involvement at all.
+type InvolvementFilter string
+
+const (
+	InvolveAll         InvolvementFilter = ""
+	InvolveRequested   InvolvementFilter = InvolvementFilter(InvRequested)
+	InvolveAny         InvolvementFilter = "involved"
+	InvolveAssignee    InvolvementFilter = InvolvementFilter(InvAssignee)
+	InvolveMentioned   InvolvementFilter = InvolvementFilter(InvMentioned)
+	InvolveParticipant InvolvementFilter = InvolvementFilter(InvParticipant)
+	InvolveAuthor      InvolvementFilter = InvolvementFilter(InvAuthor)
+)
+
+// involvementFilters lists the filters in the order the TUI cycles them.
+var involvementFilters = []InvolvementFilter{
+	InvolveAll, InvolveRequested, InvolveAny, InvolveAssignee, InvolveMentioned, InvolveParticipant, InvolveAuthor,
+}
+
+func parseInvolvementFilter(s string) (InvolvementFilter, error) {
+	if s == "all" {
+		return InvolveAll, nil
+	}
+	for _, f := range involvementFilters {
+		if f != InvolveAll && string(f) == s {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("unknown involvement %q (want all, requested, involved, assignee, mentioned, participant or author)", s)
+}
+
+func (f InvolvementFilter) String() string {
+	if f == InvolveAll {
+		return "all"
+	}
+	return string(f)
+}
+
+func (f InvolvementFilter) matches(pr ClassifiedPR) bool {
+	switch f {
+	case InvolveAll:
+		return true
+	case InvolveAny:
+		return len(pr.Involvement) > 0
+	default:
+		return slices.Contains(pr.Involvement, Involvement(f))
+	}
+}
+
+func filterInvolvement(prs []ClassifiedPR, f InvolvementFilter) []ClassifiedPR {
+	var out []ClassifiedPR
+	for _, pr := range prs {
+		if f.matches(pr) {
+			out = append(out, pr)
+		}
+	}
+	return out
+}
+
+// computeInvolvement lists every way I'm involved in a PR.
+func computeInvolvement(pr PRNode, me string, myTeams map[string]bool, mm *mentionMatcher) []Involvement {
+	if me == "" {
+		return nil
+	}
+	var inv []Involvement
+	if isRequestedReviewer(pr, me, myTeams) {
+		inv = append(inv, InvRequested)
+	}
+	for _, a := range pr.Assignees.Nodes {
+		if a.Login == me {
+			inv = append(inv, InvAssignee)
+			break
+		}
+	}
+	if wasMentioned(pr, me, mm) {
+		inv = append(inv, InvMentioned)
+	}
+	if participated(pr, me) {
+		inv = append(inv, InvParticipant)
+	}
+	if pr.Author.Login == me {
+		inv = append(inv, InvAuthor)
+	}
+	return inv
+}
+
+// wasMentioned reports whether someone else ever @-mentioned me or one of
+// my teams in the PR body, a comment, a review or an inline review comment.
+func wasMentioned(pr PRNode, me string, mm *mentionMatcher) bool {
+	for _, t := range prTexts(pr) {
+		if t.login != me && mm.mentions(pr, t.body) {
+			return true
+		}
+	}
+	return false
+}
+
+// participated reports whether I commented, reviewed or wrote in a review
+// thread on the PR.
+func participated(pr PRNode, me string) bool {
+	for _, c := range pr.Comments.Nodes {
+		if c.Author.Login == me {
+			return true
+		}
+	}
+	for _, r := range pr.Reviews.Nodes {
+		if r.Author.Login == me {
+			return true
+		}
+	}
+	for _, th := range pr.ReviewThreads.Nodes {
+		for _, c := range th.Comments.Nodes {
+			if c.Author.Login == me {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func explainInvolvement(inv []Involvement) string {
+	if len(inv) == 0 {
+		return "not involved"
+	}
+	parts := make([]string, len(inv))
+	for i, v := range inv {
+		parts[i] = string(v)
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func withAssignee(login string) func(*PRNode) {
+	return func(pr *PRNode) {
+		pr.Assignees.Nodes = append(pr.Assignees.Nodes, struct {
+			Login string `json:"login"`
+		}{login})
+	}
+}
+
+func TestComputeInvolvement(t *testing.T) {
+	myTeams := map[string]bool{"backend": true}
+	at := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name string
+		pr   PRNode
+		want []Involvement
+	}{
+		{"none", makePR(), nil},
+		{"requested via team", makePR(withReviewRequest("", "backend", false)), []Involvement{InvRequested}},
+		{"assignee", makePR(withAssignee("me")), []Involvement{InvAssignee}},
+		{"someone else assigned", makePR(withAssignee("bob")), nil},
+		{"mentioned in body", makePR(withBody("cc @me")), []Involvement{InvMentioned}},
+		{"team mentioned in comment", makePR(withCommentBody("bob", "@org/backend thoughts?", at)), []Involvement{InvMentioned}},
+		{"mentioned inline", makePR(withThreadComment("bob", "@me?", at)), []Involvement{InvMentioned}},
+		{"mentioned, then replied", makePR(withCommentBody("bob", "@me?", at), withComment("me")), []Involvement{InvMentioned, InvParticipant}},
+		{"my own mention doesn't count", makePR(withAuthor("me"), withBody("note to @me")), []Involvement{InvAuthor}},
+		{"reviewed", makePR(withReview("me", "COMMENTED", at)), []Involvement{InvParticipant}},
+		{"thread reply", makePR(withThread(false, "bob", "me")), []Involvement{InvParticipant}},
+		{"requested and assigned", makePR(withReviewRequest("me", "", false), withAssignee("me")), []Involvement{InvRequested, InvAssignee}},
+	}
+	for _, tc := range cases {
+		got := computeInvolvement(tc.pr, "me", myTeams, newMentionMatcher("me", myTeams))
+		if !slices.Equal(got, tc.want) {
+			t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestInvolvementFilter(t *testing.T) {
+	requested := ClassifiedPR{Involvement: []Involvement{InvRequested}}
+	none := ClassifiedPR{}
+	cases := []struct {
+		f    InvolvementFilter
+		pr   ClassifiedPR
+		want bool
+	}{
+		{InvolveAll, none, true},
+		{InvolveAny, none, false},
+		{InvolveAny, requested, true},
+		{InvolveRequested, requested, true},
+		{InvolveAuthor, requested, false},
+	}
+	for _, tc := range cases {
+		if got := tc.f.matches(tc.pr); got != tc.want {
+			t.Errorf("%s.matches(%v) = %v, want %v", tc.f, tc.pr.Involvement, got, tc.want)
+		}
+	}
+}
+
+func TestParseInvolvementFilter(t *testing.T) {
+	for _, s := range []string{"all", "requested", "involved", "assignee", "mentioned", "participant", "author"} {
+		f, err := parseInvolvementFilter(s)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", s, err)
+		}
+		if f.String() != s {
+			t.Errorf("%s: round-tripped to %q", s, f)
+		}
+	}
+	if _, err := parseInvolvementFilter("lurker"); err == nil {
+		t.Error("expected error for unknown involvement")
+	}
+}
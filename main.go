@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	pflag "github.com/spf13/pflag"
@@ -14,10 +15,22 @@ var version = "dev"
 func main() {
 	org := pflag.String("org", "", "GitHub organization (or set GITHUB_ORG)")
 	plain := pflag.Bool("plain", false, "Plain text output (no TUI)")
-	mine := pflag.Bool("assigned", false, "Only show PRs assigned to you for review")
-	author := pflag.Bool("author", false, "Show your own PRs and their review status")
+	mine := pflag.Bool("assigned", false, "Only show PRs requesting your review (same as --involvement=requested)")
+	involvementFlag := pflag.String("involvement", "all", "Only show PRs you're involved in: all, requested, involved, assignee, mentioned, participant, author")
+	author := pflag.Bool("author", false, "Show your own PRs and their review status (in the TUI, start in My PRs)")
+	awaitingReply := pflag.Bool("awaiting-reply", false, "Only show PRs with review threads waiting on your reply")
+	unseen := pflag.Bool("unseen", false, "Only show PRs with activity since you last saw them in the TUI")
+	slaBreach := pflag.Bool("sla-breach", false, "Only show PRs whose review request is past its SLA")
+	hideCovered := pflag.Bool("hide-covered", false, "Hide team review requests a teammate already reviewed")
+	sortFlag := pflag.String("sort", "priority", "Sort order: priority, date, small, quick-wins")
+	groupFlag := pflag.String("group-by", "none", "Group PRs under headers: none, repo, author, priority, status, team")
 	limit := pflag.Int("limit", 500, "Maximum number of PRs to fetch")
 	dismissRepos := pflag.StringSlice("dismiss-repos", nil, "Repos to hide (comma-separated)")
+	configPath := pflag.String("config", "", "Path to config file (default: "+defaultConfigPath()+")")
+	explain := pflag.Bool("explain", false, "Explain why each PR got its indicators (implies --plain)")
+	explainSort := pflag.Bool("explain-sort", false, "Show which sort rules placed each PR (implies --plain)")
+	interdiff := pflag.Bool("interdiff", false, "Print a link to the changes since your review for stale reviews (implies --plain)")
+	rawAge := pflag.Bool("raw-age", false, "Show wall-clock ages even when a working calendar is configured")
 	debug := pflag.Bool("debug", false, "Print debug info for review classification")
 	demo := pflag.Bool("demo", false, "Show demo data (for screenshots)")
 	showVersion := pflag.Bool("version", false, "Print version and exit")
@@ -42,6 +55,47 @@ func main() {
 		return
 	}
 
+	cfgPath, cfgExplicit := *configPath, *configPath != ""
+	if !cfgExplicit {
+		cfgPath = defaultConfigPath()
+	}
+	cfg, err := loadConfig(cfgPath, cfgExplicit)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+	sortMode, err := parseSortMode(*sortFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+	groupBy, err := parseGroupBy(*groupFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+	involvement, err := parseInvolvementFilter(*involvementFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+	if *mine {
+		involvement = InvolveRequested
+	}
+	seen, err := loadSeen(statePath("seen.json"))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "warning: %v\n", err)
+	}
+	dismissals, err := loadDismissals(statePath("dismissed.json"))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "warning: %v\n", err)
+	}
+	dismissals.wake(time.Now())
+	opts := plainOptions{sortMode: sortMode, age: cfg.Calendar.formatAge, config: cfg, groupBy: groupBy}
+	if *rawAge {
+		opts.age = formatAge
+	}
+
 	if _, err := ghToken(); err != nil {
 		fmt.Fprintf(os.Stderr, "error: %v\n", err)
 		os.Exit(1)
@@ -55,7 +109,7 @@ func main() {
 		}
 	}
 
-	if *debug {
+	if *debug || *explain || *explainSort || *interdiff {
 		*plain = true
 	}
 
@@ -94,14 +148,24 @@ func main() {
 			}
 		}
 
+		// Best-effort: tokens without notification access just get no dots
+		notifications, _ := fetchNotifications(*org)
+
 		if *author {
-			classified := classifyAllAuthor(prs, me, SortPriority)
+			classified := classifyAllAuthor(prs, me, sortMode, cfg)
 			classified = filterDismissedRepos(classified, dismissedRepoSet)
+			classified = filterDismissed(classified, dismissals)
+			applySeen(classified, seen)
+			applyNotifications(classified, notifications)
+			applySnoozes(classified, dismissals)
+			if *unseen {
+				classified = filterUnseen(classified)
+			}
 			if len(classified) == 0 {
 				fmt.Fprintln(os.Stderr, "No open PRs authored by you.")
 				return
 			}
-			renderPlain(os.Stdout, classified, SortPriority)
+			renderPlainOpts(os.Stdout, classified, opts)
 			return
 		}
 
@@ -110,19 +174,37 @@ func main() {
 			fmt.Fprintf(os.Stderr, "warning: could not fetch team memberships: %v\n", err)
 			myTeams = make(map[string]bool)
 		}
-		var filter func(PRNode) bool
-		if *mine {
-			filter = func(pr PRNode) bool {
-				return isRequestedReviewer(pr, me, myTeams)
-			}
-		}
-		classified := classifyAll(prs, me, myTeams, filter, SortPriority)
+		teamMembers := fetchAllTeamMembers(*org, myTeams)
+		classified := classifyAll(prs, me, myTeams, teamMembers, nil, sortMode, cfg)
 		classified = filterDismissedRepos(classified, dismissedRepoSet)
+		classified = filterDismissed(classified, dismissals)
+		classified = filterInvolvement(classified, involvement)
+		if *hideCovered {
+			classified = filterCovered(classified)
+		}
+		if *awaitingReply {
+			classified = filterAwaitingReply(classified)
+		}
+		if *slaBreach {
+			classified = filterSLABreached(classified)
+		}
+		applySeen(classified, seen)
+		applyNotifications(classified, notifications)
+		applySnoozes(classified, dismissals)
+		if *unseen {
+			classified = filterUnseen(classified)
+		}
 		if len(classified) == 0 {
 			fmt.Fprintln(os.Stderr, "No PRs pending your review.")
 			return
 		}
-		renderPlain(os.Stdout, classified, SortPriority)
+		opts.explain = *explain
+		opts.explainSort = *explainSort
+		if *interdiff {
+			resolveAllInterdiffs(classified)
+			opts.interdiff = true
+		}
+		renderPlainOpts(os.Stdout, classified, opts)
 		return
 	}
 
@@ -131,10 +213,28 @@ func main() {
 		loading:        true,
 		org:            *org,
 		limit:          *limit,
-		showAssigned:   *mine,
+		involvement:    involvement,
+		groupBy:        groupBy,
+		startInMyPRs:   *author,
+		sortMode:       sortMode,
+		hideCovered:    *hideCovered,
+		awaitingOnly:   *awaitingReply,
+		breachedOnly:   *slaBreach,
 		dismissedRepos: dismissedRepoSet,
+		dismissals:     dismissals,
+		config:         cfg,
+		rawAge:         *rawAge,
+		seen:           seen,
+		unseenOnly:     *unseen,
 	}), tea.WithAltScreen())
-	if _, err := p.Run(); err != nil {
+	_, err = p.Run()
+	if serr := seen.save(); serr != nil {
+		fmt.Fprintf(os.Stderr, "warning: %v\n", serr)
+	}
+	if serr := dismissals.save(); serr != nil {
+		fmt.Fprintf(os.Stderr, "warning: %v\n", serr)
+	}
+	if err != nil {
 		fmt.Fprintf(os.Stderr, "error: %v\n", err)
 		os.Exit(1)
 	}
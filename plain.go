@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"io"
+	"strings"
 	"time"
 )
 
@@ -31,12 +32,17 @@ func plainIndicators(pr ClassifiedPR) string {
 	switch pr.MyReview {
 	case MyNone:
 		col1 = "·"
+		if pr.RequestedVia == RequestDirect {
+			col1 = "•"
+		}
 	case MyApproved, MyApprovedStale:
 		col1 = "✓"
 	case MyChanges, MyChangesStale:
 		col1 = "✗"
 	case MyCommented, MyCommentedStale:
 		col1 = "◆"
+	case MyPending:
+		col1 = "✎"
 	default:
 		col1 = "·"
 	}
@@ -61,6 +67,8 @@ func plainIndicators(pr ClassifiedPR) string {
 		col3 = "○"
 	case ActMine, ActMineStale:
 		col3 = "●"
+	case ActMentioned:
+		col3 = "@"
 	default:
 		col3 = "·"
 	}
@@ -78,51 +86,153 @@ func plainIndicators(pr ClassifiedPR) string {
 		col4 = "·"
 	}
 
-	return col1 + " " + col2 + " " + col3 + " " + col4
+	return col1 + " " + col2 + " " + col3 + " " + col4 + " " + threadsCol(pr)
+}
+
+// threadsCol renders the review thread column as a glyph plus count,
+// padded to a fixed width: ↩N for threads awaiting my reply, ◌N for other
+// unresolved threads, · for none.
+func threadsCol(pr ClassifiedPR) string {
+	switch {
+	case pr.ThreadsAwaitingMe > 0:
+		return fmt.Sprintf("↩%-2s", threadCount(pr.ThreadsAwaitingMe))
+	case pr.UnresolvedThreads > 0:
+		return fmt.Sprintf("◌%-2s", threadCount(pr.UnresolvedThreads))
+	default:
+		return "·  "
+	}
+}
+
+func threadCount(n int) string {
+	if n > 9 {
+		return "9+"
+	}
+	return fmt.Sprint(n)
+}
+
+// displayTitle returns the PR title with any annotations shown after it.
+func displayTitle(pr ClassifiedPR) string {
+	title := pr.Title
+	if pr.Unread {
+		title = "✉ " + title
+	}
+	if pr.SnoozeEnded {
+		title = "⏰ " + title + " [snooze ended]"
+	}
+	if pr.TeamSatisfiedBy != "" && pr.MyReview == MyNone {
+		title += " (covered by " + pr.TeamSatisfiedBy + ")"
+	}
+	if s := stackSummary(pr); s != "" {
+		title += " " + s
+	}
+	if s := botSummary(pr); s != "" {
+		title += " " + s
+	}
+	switch pr.Unseen {
+	case "":
+	case "new":
+		title += " [new]"
+	default:
+		title += " [since last seen: " + pr.Unseen + "]"
+	}
+	return title
 }
 
+// formatAge formats the wall-clock time since t.
 func formatAge(t time.Time) string {
 	if t.IsZero() {
 		return " -"
 	}
-	d := time.Since(t)
+	return formatDuration(time.Since(t), wallUnits)
+}
+
+// formatDuration renders d compactly in the largest sensible unit.
+func formatDuration(d time.Duration, u ageUnits) string {
 	hours := int(d.Hours())
-	days := hours / 24
 	switch {
 	case hours < 1:
 		return "now"
-	case hours < 10:
+	case hours < 10 && d < u.day:
 		return fmt.Sprintf("%dh", hours)
-	case days < 10:
-		return fmt.Sprintf("%dd", max(days, 1))
-	case days < 30:
-		return fmt.Sprintf("%dw", days/7)
-	case days/30 < 10:
-		return fmt.Sprintf("%dm", days/30)
+	case d < 10*u.day:
+		return fmt.Sprintf("%dd", max(int(d/u.day), 1))
+	case d < u.month:
+		return fmt.Sprintf("%dw", d/u.week)
+	case d < 10*u.month:
+		return fmt.Sprintf("%dm", d/u.month)
 	default:
-		return fmt.Sprintf("%dy", max(days/365, 1))
+		return fmt.Sprintf("%dy", max(int(d/u.year), 1))
 	}
 }
 
+// plainOptions controls how renderPlainOpts prints each PR.
+type plainOptions struct {
+	sortMode    SortMode
+	age         func(time.Time) string // defaults to formatAge
+	explain     bool                   // add why each indicator was chosen
+	explainSort bool                   // add the steps that decided sort priority
+	interdiff   bool                   // add the "since my review" URL for stale reviews
+	config      Config                 // thresholds the explanations name
+	groupBy     GroupBy                // print PRs in groups under headers
+}
+
 func renderPlain(w io.Writer, items []ClassifiedPR, sortMode SortMode) {
+	renderPlainOpts(w, items, plainOptions{sortMode: sortMode})
+}
+
+func renderPlainOpts(w io.Writer, items []ClassifiedPR, opts plainOptions) {
+	if opts.age == nil {
+		opts.age = formatAge
+	}
 	cols := computeColumns(items)
+	if opts.groupBy == GroupNone {
+		renderPlainItems(w, items, cols, opts)
+		return
+	}
+	for i, g := range groupPRs(items, opts.groupBy) {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "%s (%d)\n", g.Key, len(g.Items))
+		renderPlainItems(w, g.Items, cols, opts)
+	}
+}
+
+func renderPlainItems(w io.Writer, items []ClassifiedPR, cols colWidths, opts plainOptions) {
 	for _, pr := range items {
-		repoCol := fmt.Sprintf("%s#%d", pr.RepoName, pr.Number)
-		indicators := plainIndicators(pr)
-		ageTime := pr.CreatedAt
-		if sortMode == SortDate {
-			ageTime = pr.LastActivity
+		fmt.Fprintln(w, plainLine(pr, cols, opts))
+		if opts.interdiff && pr.InterdiffURL != "" {
+			fmt.Fprintf(w, "    since your review: %s\n", pr.InterdiffURL)
+		}
+		if opts.explainSort {
+			fmt.Fprintf(w, "    sort: %s\n", strings.Join(pr.PriorityExplain, ", "))
 		}
-		age := formatAge(ageTime)
-		title := pr.Title
-		if pr.IsDraft {
-			title = "[draft] " + title
+		if opts.explain {
+			for _, l := range explainLines(pr, opts.config) {
+				fmt.Fprintf(w, "    %s\n", l)
+			}
 		}
-		fmt.Fprintf(w, "%s %-*s  %-*s  %4s  %s\n",
-			indicators,
-			cols.repo, repoCol,
-			cols.author, pr.Author,
-			age,
-			title)
 	}
 }
+
+func plainLine(pr ClassifiedPR, cols colWidths, opts plainOptions) string {
+	repoCol := fmt.Sprintf("%s#%d", pr.RepoName, pr.Number)
+	indicators := plainIndicators(pr)
+	ageTime := pr.CreatedAt
+	if opts.sortMode == SortDate {
+		ageTime = pr.LastActivity
+	}
+	age := opts.age(ageTime)
+	title := displayTitle(pr)
+	if pr.IsDraft {
+		title = "[draft] " + title
+	}
+	title = stackPrefix(pr) + title
+	return fmt.Sprintf("%s %-*s  %-*s  %4s  %-2s  %s",
+		indicators,
+		cols.repo, repoCol,
+		cols.author, pr.Author,
+		age,
+		pr.Size,
+		title)
+}
@@ -15,6 +15,7 @@ func TestRenderPlain(t *testing.T) {
 			Number:   42,
 			Title:    "Add endpoint",
 			Author:   "alice",
+			Size:     SizeS,
 		},
 		{
 			MyReview: MyApprovedStale, OthReview: OthNone, Activity: ActNone,
@@ -22,6 +23,7 @@ func TestRenderPlain(t *testing.T) {
 			Number:   7,
 			Title:    "Fix layout",
 			Author:   "bob",
+			Size:     SizeXL,
 		},
 	}
 
@@ -33,13 +35,13 @@ func TestRenderPlain(t *testing.T) {
 		t.Fatalf("expected 2 lines, got %d: %q", len(lines), buf.String())
 	}
 
-	// Columns are padded: repo to 6 (api#42), author to 5 (alice), age 4 chars right-aligned
-	expected0 := "· ✓ ● · api#42  alice     -  Add endpoint"
+	// Columns are padded: repo to 6 (api#42), author to 5 (alice), age 4 chars right-aligned, size to 2
+	expected0 := "· ✓ ● · ·   api#42  alice     -  S   Add endpoint"
 	if lines[0] != expected0 {
 		t.Fatalf("line 0:\ngot:  %q\nwant: %q", lines[0], expected0)
 	}
 
-	expected1 := "✓ · · · web#7   bob       -  Fix layout"
+	expected1 := "✓ · · · ·   web#7   bob       -  XL  Fix layout"
 	if lines[1] != expected1 {
 		t.Fatalf("line 1:\ngot:  %q\nwant: %q", lines[1], expected1)
 	}
@@ -132,3 +134,38 @@ func TestRenderPlain_NewFormat(t *testing.T) {
 		t.Error("expected bob in output")
 	}
 }
+
+func TestPlainIndicators_Pending(t *testing.T) {
+	pr := ClassifiedPR{MyReview: MyPending, OthReview: OthNone, Activity: ActNone, Status: StatusNone}
+	if got := plainIndicators(pr); got != "✎ · · · ·  " {
+		t.Errorf("plainIndicators(pending) = %q, want %q", got, "✎ · · · ·  ")
+	}
+}
+
+func TestThreadsCol(t *testing.T) {
+	cases := []struct {
+		pr   ClassifiedPR
+		want string
+	}{
+		{ClassifiedPR{}, "·  "},
+		{ClassifiedPR{UnresolvedThreads: 3}, "◌3 "},
+		{ClassifiedPR{UnresolvedThreads: 3, ThreadsAwaitingMe: 2}, "↩2 "},
+		{ClassifiedPR{UnresolvedThreads: 12}, "◌9+"},
+	}
+	for _, tc := range cases {
+		if got := threadsCol(tc.pr); got != tc.want {
+			t.Errorf("threadsCol(%+v) = %q, want %q", tc.pr, got, tc.want)
+		}
+	}
+}
+
+func TestRenderPlainSortExplain(t *testing.T) {
+	items := []ClassifiedPR{
+		{RepoName: "r", Number: 1, Author: "a", Title: "t", PriorityExplain: []string{"default bucket 8", `rule "old": -1 → 7`}},
+	}
+	var buf bytes.Buffer
+	renderPlainOpts(&buf, items, plainOptions{sortMode: SortPriority, explainSort: true})
+	if !strings.Contains(buf.String(), `sort: default bucket 8, rule "old": -1 → 7`) {
+		t.Errorf("expected sort explanation, got %q", buf.String())
+	}
+}
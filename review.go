@@ -0,0 +1,125 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ReviewEvent is the kind of review to submit, as the pull request reviews
+// API names it.
+type ReviewEvent string
+
+const (
+	ReviewApprove        ReviewEvent = "APPROVE"
+	ReviewRequestChanges ReviewEvent = "REQUEST_CHANGES"
+	ReviewComment        ReviewEvent = "COMMENT"
+)
+
+// reviewKeys maps the keys offered after e to review events.
+var reviewKeys = map[string]ReviewEvent{
+	"a": ReviewApprove,
+	"r": ReviewRequestChanges,
+	"c": ReviewComment,
+}
+
+func (e ReviewEvent) verb() string {
+	switch e {
+	case ReviewApprove:
+		return "approval"
+	case ReviewRequestChanges:
+		return "change request"
+	default:
+		return "review comment"
+	}
+}
+
+// state is the review state GitHub records for the event.
+func (e ReviewEvent) state() string {
+	switch e {
+	case ReviewApprove:
+		return "APPROVED"
+	case ReviewRequestChanges:
+		return "CHANGES_REQUESTED"
+	default:
+		return "COMMENTED"
+	}
+}
+
+// reviewDraft is a review written in the editor, awaiting confirmation.
+type reviewDraft struct {
+	pr    ClassifiedPR
+	event ReviewEvent
+	body  string
+}
+
+func reviewFileContent(pr ClassifiedPR, event ReviewEvent) string {
+	return fmt.Sprintf("\n%s\n# Write your %s for %s#%d above this line:\n# %s\n# %s\n",
+		scissors, event.verb(), pr.RepoName, pr.Number, pr.Title, pr.URL)
+}
+
+type reviewEditedMsg struct {
+	draft reviewDraft
+	err   error
+}
+
+// editReviewCmd suspends the TUI to write the review body in the editor.
+func editReviewCmd(pr ClassifiedPR, event ReviewEvent) tea.Cmd {
+	return editTextCmd(reviewFileContent(pr, event), func(text string, err error) tea.Msg {
+		return reviewEditedMsg{draft: reviewDraft{pr: pr, event: event, body: text}, err: err}
+	})
+}
+
+type reviewSubmittedMsg struct {
+	draft reviewDraft
+	// pending is set when my existing pending review was submitted
+	// rather than a new one created.
+	pending bool
+	err     error
+}
+
+func submitReviewCmd(d reviewDraft, me string) tea.Cmd {
+	return func() tea.Msg {
+		pending, err := submitReview(d.pr.RepoFullName, d.pr.Number, me, d.event, d.body)
+		return reviewSubmittedMsg{draft: d, pending: pending, err: err}
+	}
+}
+
+// recordReview adds a review I just submitted to the raw PR, as the next
+// fetch would, so its indicators update without a refresh.
+func recordReview(prs []PRNode, me string, d reviewDraft) {
+	for i := range prs {
+		pr := &prs[i]
+		if pr.URL != d.pr.URL {
+			continue
+		}
+		r := ReviewNode{State: d.event.state(), Body: d.body, SubmittedAt: time.Now()}
+		r.Author.Login = me
+		if pr.HeadRefOid != "" {
+			r.Commit = &struct {
+				OID string `json:"oid"`
+			}{pr.HeadRefOid}
+		}
+		// My pending review, if any, is the one that got submitted
+		var reviews []ReviewNode
+		for _, old := range pr.Reviews.Nodes {
+			if old.Author.Login != me || old.State != "PENDING" {
+				reviews = append(reviews, old)
+			}
+		}
+		if len(reviews) == len(pr.Reviews.Nodes) {
+			pr.Reviews.TotalCount++
+		}
+		pr.Reviews.Nodes = append(reviews, r)
+		// Submitting a review fulfils my direct request
+		var requests []ReviewRequestNode
+		for _, rr := range pr.ReviewRequests.Nodes {
+			if rr.RequestedReviewer.Login != me {
+				requests = append(requests, rr)
+			}
+		}
+		pr.ReviewRequests.Nodes = requests
+		return
+	}
+}
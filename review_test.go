@@ -0,0 +1,32 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRecordReview(t *testing.T) {
+	prs := []PRNode{
+		makePR(withReviewRequest("me", "", false), withReviewRequest("", "backend", false)),
+	}
+	d := reviewDraft{pr: ClassifiedPR{URL: prs[0].URL}, event: ReviewRequestChanges, body: "see inline"}
+	recordReview(prs, "me", d)
+	if got := computeMyReview(prs[0], "me"); got != MyChanges {
+		t.Errorf("expected MyChanges after recording, got %s", got)
+	}
+	if len(prs[0].ReviewRequests.Nodes) != 1 || prs[0].ReviewRequests.Nodes[0].RequestedReviewer.Slug != "backend" {
+		t.Errorf("expected only my direct request removed, got %+v", prs[0].ReviewRequests.Nodes)
+	}
+}
+
+func TestRecordReview_SubmitsPending(t *testing.T) {
+	prs := []PRNode{makePR(withReview("me", "PENDING", time.Time{}))}
+	d := reviewDraft{pr: ClassifiedPR{URL: prs[0].URL}, event: ReviewApprove}
+	recordReview(prs, "me", d)
+	if got := computeMyReview(prs[0], "me"); got != MyApproved {
+		t.Errorf("expected MyApproved once the pending review is submitted, got %s", got)
+	}
+	if len(prs[0].Reviews.Nodes) != 1 {
+		t.Errorf("expected the pending review replaced, got %+v", prs[0].Reviews.Nodes)
+	}
+}
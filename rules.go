@@ -0,0 +1,137 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SortRule adjusts a PR's sort priority when every condition in Match
+// holds. A rule with Bucket set places the PR in that bucket outright and
+// stops evaluation; otherwise Score is added to the priority and later
+// rules still apply. Lower priorities sort first.
+type SortRule struct {
+	Name   string    `json:"name"`
+	Match  RuleMatch `json:"match"`
+	Bucket *int      `json:"bucket,omitempty"`
+	Score  int       `json:"score,omitempty"`
+}
+
+// RuleMatch lists the conditions a rule checks. Empty fields match
+// anything. List fields match if the PR has any of the listed values.
+type RuleMatch struct {
+	MyReview    []string `json:"myReview,omitempty"`
+	OthReview   []string `json:"othReview,omitempty"`
+	Activity    []string `json:"activity,omitempty"`
+	Status      []string `json:"status,omitempty"`
+	Request     []string `json:"request,omitempty"`
+	SLA         []string `json:"sla,omitempty"`
+	Size        []string `json:"size,omitempty"`
+	Involvement []string `json:"involvement,omitempty"`
+	Repos       []string `json:"repos,omitempty"`
+	Authors     []string `json:"authors,omitempty"`
+	Labels      []string `json:"labels,omitempty"`
+	OlderThan   string   `json:"olderThan,omitempty"`
+	Draft       *bool    `json:"draft,omitempty"`
+
+	olderThan time.Duration
+}
+
+// parseAge parses a duration that may also use d (days) and w (weeks)
+// units, e.g. "3d", "2w", "36h".
+func parseAge(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	for suffix, unit := range map[string]time.Duration{"d": 24 * time.Hour, "w": 7 * 24 * time.Hour} {
+		if num, ok := strings.CutSuffix(s, suffix); ok {
+			n, err := strconv.ParseFloat(num, 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid age %q", s)
+			}
+			return time.Duration(n * float64(unit)), nil
+		}
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid age %q", s)
+	}
+	return d, nil
+}
+
+func (r *SortRule) compile() error {
+	return r.Match.compile()
+}
+
+func (rm *RuleMatch) compile() error {
+	if rm.OlderThan != "" {
+		d, err := parseAge(rm.OlderThan)
+		if err != nil {
+			return err
+		}
+		rm.olderThan = d
+	}
+	return nil
+}
+
+func matchesAny(values []string, v string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, want := range values {
+		if strings.EqualFold(want, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func (rm RuleMatch) matches(pr ClassifiedPR) bool {
+	if !matchesAny(rm.MyReview, string(pr.MyReview)) ||
+		!matchesAny(rm.OthReview, string(pr.OthReview)) ||
+		!matchesAny(rm.Activity, string(pr.Activity)) ||
+		!matchesAny(rm.Status, string(pr.Status)) ||
+		!matchesAny(rm.Request, string(pr.RequestedVia)) ||
+		!matchesAny(rm.SLA, string(pr.SLA)) ||
+		!matchesAny(rm.Size, string(pr.Size)) ||
+		!matchesAny(rm.Authors, pr.Author) {
+		return false
+	}
+	if len(rm.Repos) > 0 && !matchesAny(rm.Repos, pr.RepoName) && !matchesAny(rm.Repos, pr.RepoFullName) {
+		return false
+	}
+	if len(rm.Involvement) > 0 && !slices.ContainsFunc(pr.Involvement, func(v Involvement) bool { return matchesAny(rm.Involvement, string(v)) }) {
+		return false
+	}
+	if len(rm.Labels) > 0 && !slices.ContainsFunc(pr.Labels, func(l string) bool { return matchesAny(rm.Labels, l) }) {
+		return false
+	}
+	if rm.olderThan > 0 && (pr.CreatedAt.IsZero() || pr.Age < rm.olderThan) {
+		return false
+	}
+	if rm.Draft != nil && pr.IsDraft != *rm.Draft {
+		return false
+	}
+	return true
+}
+
+// applyRules starts from the built-in priority and applies each matching
+// rule in order. It returns the final priority and a human-readable trail
+// of how it was reached.
+func applyRules(pr ClassifiedPR, rules []SortRule, priorityFn func(ClassifiedPR) int) (int, []string) {
+	priority := priorityFn(pr)
+	explain := []string{fmt.Sprintf("default bucket %d", priority)}
+	for _, r := range rules {
+		if !r.Match.matches(pr) {
+			continue
+		}
+		if r.Bucket != nil {
+			priority = *r.Bucket
+			explain = append(explain, fmt.Sprintf("rule %q: bucket %d", r.Name, priority))
+			break
+		}
+		priority += r.Score
+		explain = append(explain, fmt.Sprintf("rule %q: %+d → %d", r.Name, r.Score, priority))
+	}
+	return priority, explain
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func intPtr(n int) *int { return &n }
+
+func TestParseAge(t *testing.T) {
+	cases := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"3d", 72 * time.Hour},
+		{"1w", 7 * 24 * time.Hour},
+		{"36h", 36 * time.Hour},
+		{"1.5d", 36 * time.Hour},
+	}
+	for _, tc := range cases {
+		got, err := parseAge(tc.in)
+		if err != nil {
+			t.Errorf("parseAge(%q): unexpected error %v", tc.in, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("parseAge(%q) = %v, want %v", tc.in, got, tc.want)
+		}
+	}
+	if _, err := parseAge("soon"); err == nil {
+		t.Error("expected error for invalid age")
+	}
+}
+
+func TestRuleMatch(t *testing.T) {
+	yes := true
+	pr := ClassifiedPR{
+		MyReview:     MyNone,
+		Status:       StatusFail,
+		RepoName:     "billing-svc",
+		RepoFullName: "org/billing-svc",
+		Author:       "alice",
+		Labels:       []string{"bug", "Hotfix"},
+		CreatedAt:    time.Now().Add(-4 * 24 * time.Hour),
+		Age:          4 * 24 * time.Hour,
+	}
+	cases := []struct {
+		name  string
+		match RuleMatch
+		want  bool
+	}{
+		{"empty matches all", RuleMatch{}, true},
+		{"status", RuleMatch{Status: []string{"fail"}}, true},
+		{"status mismatch", RuleMatch{Status: []string{"pass", "pending"}}, false},
+		{"label case-insensitive", RuleMatch{Labels: []string{"hotfix"}}, true},
+		{"label missing", RuleMatch{Labels: []string{"security"}}, false},
+		{"repo short name", RuleMatch{Repos: []string{"billing-svc"}}, true},
+		{"repo full name", RuleMatch{Repos: []string{"org/billing-svc"}}, true},
+		{"author and status", RuleMatch{Authors: []string{"alice"}, Status: []string{"pass"}}, false},
+		{"older than", RuleMatch{olderThan: 3 * 24 * time.Hour}, true},
+		{"not older than", RuleMatch{olderThan: 7 * 24 * time.Hour}, false},
+		{"draft", RuleMatch{Draft: &yes}, false},
+	}
+	for _, tc := range cases {
+		if got := tc.match.matches(pr); got != tc.want {
+			t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestApplyRules(t *testing.T) {
+	rules := []SortRule{
+		{Name: "boost billing", Match: RuleMatch{Repos: []string{"billing-svc"}}, Score: -2},
+		{Name: "failing last", Match: RuleMatch{Status: []string{"fail"}}, Bucket: intPtr(100)},
+		{Name: "never reached", Match: RuleMatch{}, Score: 50},
+	}
+	fixed := func(ClassifiedPR) int { return 5 }
+
+	got, explain := applyRules(ClassifiedPR{RepoName: "billing-svc", Status: StatusPass}, rules, fixed)
+	if got != 53 {
+		t.Errorf("expected 5-2+50=53, got %d", got)
+	}
+	if len(explain) != 3 || !strings.Contains(explain[1], "boost billing") {
+		t.Errorf("unexpected explanation %v", explain)
+	}
+
+	got, explain = applyRules(ClassifiedPR{RepoName: "billing-svc", Status: StatusFail}, rules, fixed)
+	if got != 100 {
+		t.Errorf("expected bucket rule to set 100, got %d", got)
+	}
+	if !strings.Contains(explain[len(explain)-1], "failing last") {
+		t.Errorf("expected bucket rule last in explanation, got %v", explain)
+	}
+
+	got, explain = applyRules(ClassifiedPR{}, nil, fixed)
+	if got != 5 || len(explain) != 1 {
+		t.Errorf("expected default bucket with no rules, got %d %v", got, explain)
+	}
+}
+
+func TestClassifyAll_RulesReorder(t *testing.T) {
+	prs := []PRNode{
+		makePR(withAuthor("alice"), withReviewRequest("me", "", false), withURL("https://github.com/org/repo/pull/1")),
+		makePR(withAuthor("bob"), withURL("https://github.com/org/repo/pull/2")),
+	}
+	prs[1].Labels.Nodes = append(prs[1].Labels.Nodes, struct {
+		Name string `json:"name"`
+	}{Name: "hotfix"})
+	rules := []SortRule{{Name: "hotfix first", Match: RuleMatch{Labels: []string{"hotfix"}}, Bucket: intPtr(-1)}}
+
+	result := classifyAll(prs, "me", nil, nil, nil, SortPriority, Config{Rules: rules})
+	if result[0].Author != "bob" {
+		t.Errorf("expected hotfix PR first, got %s", result[0].Author)
+	}
+	if result[0].Priority != -1 {
+		t.Errorf("expected priority -1, got %d", result[0].Priority)
+	}
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// seenEntry records when I last saw a PR and how much activity it had then.
+type seenEntry struct {
+	At       time.Time `json:"at"`
+	Commits  int       `json:"commits"`
+	Comments int       `json:"comments"`
+	Reviews  int       `json:"reviews"`
+}
+
+// seenRetention is how long entries for PRs I haven't looked at are kept.
+const seenRetention = 90 * 24 * time.Hour
+
+// seenStore persists, per PR URL, when I last saw each PR across sessions.
+// PRs opened before since, when the store started, that I never saw are
+// taken as seen as they were when first listed, so only PRs opened later
+// count as new.
+type seenStore struct {
+	path    string
+	since   time.Time
+	entries map[string]seenEntry
+	dirty   bool
+}
+
+// seenFile is the store on disk.
+type seenFile struct {
+	Since time.Time            `json:"since"`
+	PRs   map[string]seenEntry `json:"prs"`
+}
+
+// loadSeen reads the store at path. A missing file gives an empty store
+// starting now.
+func loadSeen(path string) (*seenStore, error) {
+	s := &seenStore{path: path, since: time.Now(), entries: make(map[string]seenEntry)}
+	data, err := readStateFile(path)
+	if err != nil {
+		return s, fmt.Errorf("reading seen state: %w", err)
+	}
+	if data == nil {
+		return s, nil
+	}
+	var f seenFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return s, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	if f.PRs != nil {
+		s.since, s.entries = f.Since, f.PRs
+	} else if err := json.Unmarshal(data, &s.entries); err != nil {
+		// A bare map of entries, from before the store had a start time
+		return s, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// save writes the store if anything changed, dropping entries not
+// refreshed within seenRetention.
+func (s *seenStore) save() error {
+	if !s.unsaved() {
+		return nil
+	}
+	for url, e := range s.entries {
+		if time.Since(e.At) > seenRetention {
+			delete(s.entries, url)
+		}
+	}
+	data, err := json.MarshalIndent(seenFile{Since: s.since, PRs: s.entries}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeStateFile(s.path, data); err != nil {
+		return fmt.Errorf("saving seen state: %w", err)
+	}
+	s.dirty = false
+	return nil
+}
+
+// unsaved reports whether the store has changes to write.
+func (s *seenStore) unsaved() bool {
+	return s != nil && s.dirty && s.path != ""
+}
+
+// markSeen records the PR's current activity as seen now.
+func (s *seenStore) markSeen(pr ClassifiedPR) {
+	if s == nil || pr.URL == "" {
+		return
+	}
+	s.entries[pr.URL] = seenEntry{At: time.Now(), Commits: pr.CommitCount, Comments: pr.CommentCount, Reviews: pr.ReviewCount}
+	s.dirty = true
+}
+
+// baseline records PRs opened before the store started that I've never
+// seen, with their activity as it is now, so later activity still shows.
+// Without it, every PR the cursor never reached would stay new.
+func (s *seenStore) baseline(items []ClassifiedPR) {
+	if s == nil {
+		return
+	}
+	for _, pr := range items {
+		if _, ok := s.entries[pr.URL]; ok || pr.URL == "" || pr.CreatedAt.After(s.since) {
+			continue
+		}
+		s.entries[pr.URL] = seenEntry{At: time.Now(), Commits: pr.CommitCount, Comments: pr.CommentCount, Reviews: pr.ReviewCount}
+		s.dirty = true
+	}
+}
+
+// unseen summarizes what happened on a PR since I last saw it, or returns
+// "" if nothing did. A PR opened since the store started that I've never
+// seen is "new".
+func (s *seenStore) unseen(pr ClassifiedPR) string {
+	if s == nil {
+		return ""
+	}
+	e, ok := s.entries[pr.URL]
+	if !ok {
+		if pr.CreatedAt.After(s.since) {
+			return "new"
+		}
+		return ""
+	}
+	var parts []string
+	for _, c := range []struct {
+		n    int
+		noun string
+	}{
+		{pr.CommitCount - e.Commits, "commit"},
+		{pr.CommentCount - e.Comments, "comment"},
+		{pr.ReviewCount - e.Reviews, "review"},
+	} {
+		if c.n > 0 {
+			parts = append(parts, plural(c.n, c.noun))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// applySeen sets each PR's Unseen summary from the store, first taking
+// any PRs it predates as a baseline.
+func applySeen(items []ClassifiedPR, s *seenStore) {
+	s.baseline(items)
+	for i := range items {
+		items[i].Unseen = s.unseen(items[i])
+	}
+}
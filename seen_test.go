@@ -0,0 +1,93 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSeenStore_Unseen(t *testing.T) {
+	s, err := loadSeen("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pr := ClassifiedPR{URL: "u1", CommitCount: 3, CommentCount: 2, ReviewCount: 1}
+	if got := s.unseen(pr); got != "" {
+		t.Errorf("empty store should flag nothing, got %q", got)
+	}
+
+	s.markSeen(pr)
+	if got := s.unseen(pr); got != "" {
+		t.Errorf("just seen: got %q, want no activity", got)
+	}
+	pr.CommitCount, pr.CommentCount = 5, 3
+	if got, want := s.unseen(pr), "2 commits, 1 comment"; got != want {
+		t.Errorf("unseen = %q, want %q", got, want)
+	}
+	if got := s.unseen(ClassifiedPR{URL: "u2", CreatedAt: time.Now().Add(time.Minute)}); got != "new" {
+		t.Errorf("never-seen PR: got %q, want %q", got, "new")
+	}
+}
+
+func TestSeenStore_Baseline(t *testing.T) {
+	s, _ := loadSeen("")
+	old := ClassifiedPR{URL: "u1", CreatedAt: s.since.Add(-time.Hour), CommentCount: 2}
+	items := []ClassifiedPR{old}
+	applySeen(items, s)
+	if items[0].Unseen != "" || !s.dirty {
+		t.Fatalf("expected a PR older than the store taken as seen, got %q", items[0].Unseen)
+	}
+
+	// It still shows activity after the baseline
+	items[0].CommentCount = 3
+	applySeen(items, s)
+	if items[0].Unseen != "1 comment" {
+		t.Errorf("unseen = %q, want %q", items[0].Unseen, "1 comment")
+	}
+}
+
+func TestSeenStore_SaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pr-patrol", "seen.json")
+	s, err := loadSeen(path)
+	if err != nil {
+		t.Fatalf("missing file should load empty: %v", err)
+	}
+	s.markSeen(ClassifiedPR{URL: "u1", CommitCount: 4})
+	s.entries["old"] = seenEntry{At: time.Now().Add(-2 * seenRetention)}
+	if err := s.save(); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := loadSeen(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.entries["u1"].Commits != 4 {
+		t.Errorf("expected u1 with 4 commits, got %+v", loaded.entries["u1"])
+	}
+	if _, ok := loaded.entries["old"]; ok {
+		t.Error("expected expired entry to be pruned")
+	}
+	if !loaded.since.Equal(s.since) {
+		t.Errorf("expected start time kept, got %v want %v", loaded.since, s.since)
+	}
+
+	// A bare map of entries still loads
+	if err := os.WriteFile(path, []byte(`{"u1": {"commits": 2}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if loaded, err = loadSeen(path); err != nil || loaded.entries["u1"].Commits != 2 {
+		t.Errorf("expected old format to load, got %+v, %v", loaded.entries, err)
+	}
+}
+
+func TestLoadSeen_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "seen.json")
+	if err := os.WriteFile(path, []byte("{not json"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadSeen(path); err == nil {
+		t.Fatal("expected error for invalid seen file")
+	}
+}
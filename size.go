@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// PRSize is a t-shirt size for a PR's diff, by lines changed.
+type PRSize string
+
+const (
+	SizeXS PRSize = "XS"
+	SizeS  PRSize = "S"
+	SizeM  PRSize = "M"
+	SizeL  PRSize = "L"
+	SizeXL PRSize = "XL"
+)
+
+// SizeConfig sets the most lines changed (additions + deletions) for each
+// size; anything above L is XL. Zero fields use the defaults.
+type SizeConfig struct {
+	XS int `json:"xs,omitempty"` // default 10
+	S  int `json:"s,omitempty"`  // default 50
+	M  int `json:"m,omitempty"`  // default 250
+	L  int `json:"l,omitempty"`  // default 1000
+}
+
+func (c SizeConfig) withDefaults() SizeConfig {
+	if c.XS == 0 {
+		c.XS = 10
+	}
+	if c.S == 0 {
+		c.S = 50
+	}
+	if c.M == 0 {
+		c.M = 250
+	}
+	if c.L == 0 {
+		c.L = 1000
+	}
+	return c
+}
+
+func (c SizeConfig) validate() error {
+	d := c.withDefaults()
+	if d.XS < 0 || d.XS >= d.S || d.S >= d.M || d.M >= d.L {
+		return fmt.Errorf("thresholds must increase: xs %d, s %d, m %d, l %d", d.XS, d.S, d.M, d.L)
+	}
+	return nil
+}
+
+// classify returns the size for a diff and the threshold it fell under
+// (0 for XL).
+func (c SizeConfig) classify(lines int) (PRSize, int) {
+	d := c.withDefaults()
+	switch {
+	case lines <= d.XS:
+		return SizeXS, d.XS
+	case lines <= d.S:
+		return SizeS, d.S
+	case lines <= d.M:
+		return SizeM, d.M
+	case lines <= d.L:
+		return SizeL, d.L
+	default:
+		return SizeXL, 0
+	}
+}
+
+// reviewEffort is a rough guess at how long a review takes: about 300
+// changed lines an hour plus a minute per file, never under five minutes,
+// rounded to five minutes.
+func reviewEffort(lines, files int) time.Duration {
+	est := time.Duration(lines)*time.Hour/300 + time.Duration(files)*time.Minute
+	return max(est.Round(5*time.Minute), 5*time.Minute)
+}
+
+func explainSize(pr ClassifiedPR, c SizeConfig) string {
+	lines := pr.Additions + pr.Deletions
+	_, limit := c.classify(lines)
+	bound := fmt.Sprintf("up to %d lines", limit)
+	if limit == 0 {
+		bound = fmt.Sprintf("over %d lines", c.withDefaults().L)
+	}
+	return fmt.Sprintf("%s (%s): +%d −%d in %d files, about %s to review",
+		pr.Size, bound, pr.Additions, pr.Deletions, pr.ChangedFiles, fmtEffort(reviewEffort(lines, pr.ChangedFiles)))
+}
+
+func fmtEffort(d time.Duration) string {
+	if d < time.Hour {
+		return fmt.Sprintf("%d min", int(d.Minutes()))
+	}
+	return fmt.Sprintf("%.1f h", d.Hours())
+}
+
+// isQuickWin reports whether a PR is small, waiting on my review, and not
+// blocked on CI or conflicts — something to clear between meetings.
+func isQuickWin(pr ClassifiedPR) bool {
+	if pr.IsDraft || (pr.Size != SizeXS && pr.Size != SizeS) {
+		return false
+	}
+	return !hasCurrentReview(pr) && pr.Status != StatusFail && pr.Status != StatusConflict
+}
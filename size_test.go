@@ -0,0 +1,83 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSizeClassify(t *testing.T) {
+	cases := []struct {
+		lines int
+		cfg   SizeConfig
+		want  PRSize
+	}{
+		{0, SizeConfig{}, SizeXS},
+		{10, SizeConfig{}, SizeXS},
+		{11, SizeConfig{}, SizeS},
+		{250, SizeConfig{}, SizeM},
+		{1000, SizeConfig{}, SizeL},
+		{1001, SizeConfig{}, SizeXL},
+		{30, SizeConfig{XS: 5, S: 20}, SizeM},
+	}
+	for _, tc := range cases {
+		if got, _ := tc.cfg.classify(tc.lines); got != tc.want {
+			t.Errorf("classify(%d) with %+v = %s, want %s", tc.lines, tc.cfg, got, tc.want)
+		}
+	}
+}
+
+func TestSizeConfigValidate(t *testing.T) {
+	if err := (SizeConfig{S: 100, M: 400}).validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := (SizeConfig{S: 300}).validate(); err == nil {
+		t.Error("expected error when s exceeds the default m")
+	}
+}
+
+func TestReviewEffort(t *testing.T) {
+	cases := []struct {
+		lines, files int
+		want         time.Duration
+	}{
+		{2, 1, 5 * time.Minute},
+		{150, 5, 35 * time.Minute},
+		{600, 20, 2*time.Hour + 20*time.Minute},
+	}
+	for _, tc := range cases {
+		if got := reviewEffort(tc.lines, tc.files); got != tc.want {
+			t.Errorf("reviewEffort(%d, %d) = %v, want %v", tc.lines, tc.files, got, tc.want)
+		}
+	}
+}
+
+func TestExplainSize(t *testing.T) {
+	pr := ClassifiedPR{Size: SizeS, Additions: 30, Deletions: 5, ChangedFiles: 3}
+	got := explainSize(pr, SizeConfig{})
+	for _, want := range []string{"S (up to 50 lines)", "+30 −5 in 3 files", "about 10 min"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("explainSize = %q, want it to contain %q", got, want)
+		}
+	}
+}
+
+func TestIsQuickWin(t *testing.T) {
+	cases := []struct {
+		name string
+		pr   ClassifiedPR
+		want bool
+	}{
+		{"small, unreviewed", ClassifiedPR{Size: SizeS, MyReview: MyNone, Status: StatusPass}, true},
+		{"stale review", ClassifiedPR{Size: SizeXS, MyReview: MyApprovedStale}, true},
+		{"too big", ClassifiedPR{Size: SizeM, MyReview: MyNone}, false},
+		{"already reviewed", ClassifiedPR{Size: SizeXS, MyReview: MyApproved}, false},
+		{"failing CI", ClassifiedPR{Size: SizeXS, MyReview: MyNone, Status: StatusFail}, false},
+		{"draft", ClassifiedPR{Size: SizeXS, MyReview: MyNone, IsDraft: true}, false},
+	}
+	for _, tc := range cases {
+		if got := isQuickWin(tc.pr); got != tc.want {
+			t.Errorf("%s: isQuickWin = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SLAState is where a PR stands against the review SLA, measured from when
+// my review was requested.
+type SLAState string
+
+const (
+	SLANone   SLAState = "none" // no outstanding request, or no SLA configured
+	SLAOk     SLAState = "ok"
+	SLAWarn   SLAState = "warn"
+	SLABreach SLAState = "breach"
+)
+
+// SLAThresholds are the elapsed times after which a request is in warning
+// or in breach. Either may be empty to skip that level.
+type SLAThresholds struct {
+	Warn   string `json:"warn,omitempty"`
+	Breach string `json:"breach,omitempty"`
+
+	warn, breach time.Duration
+}
+
+// SLAConfig sets global thresholds with per-repo and per-label overrides.
+// A matching label beats a matching repo, which beats the global default;
+// among several matching labels the one that breaches soonest wins.
+type SLAConfig struct {
+	SLAThresholds
+	Repos  map[string]SLAThresholds `json:"repos,omitempty"`
+	Labels map[string]SLAThresholds `json:"labels,omitempty"`
+}
+
+func (t *SLAThresholds) compile() error {
+	var err error
+	if t.Warn != "" {
+		if t.warn, err = parseAge(t.Warn); err != nil {
+			return fmt.Errorf("warn: %w", err)
+		}
+	}
+	if t.Breach != "" {
+		if t.breach, err = parseAge(t.Breach); err != nil {
+			return fmt.Errorf("breach: %w", err)
+		}
+	}
+	return nil
+}
+
+func (t SLAThresholds) empty() bool {
+	return t.warn == 0 && t.breach == 0
+}
+
+func (c *SLAConfig) compile() error {
+	if err := c.SLAThresholds.compile(); err != nil {
+		return err
+	}
+	for name, t := range c.Repos {
+		if err := t.compile(); err != nil {
+			return fmt.Errorf("repo %s: %w", name, err)
+		}
+		c.Repos[name] = t
+	}
+	for name, t := range c.Labels {
+		if err := t.compile(); err != nil {
+			return fmt.Errorf("label %s: %w", name, err)
+		}
+		c.Labels[name] = t
+	}
+	return nil
+}
+
+// thresholdsFor picks the thresholds that apply to a PR and names where
+// they came from.
+func (c SLAConfig) thresholdsFor(pr ClassifiedPR) (SLAThresholds, string) {
+	var best SLAThresholds
+	var source string
+	for _, l := range pr.Labels {
+		t, ok := lookupFold(c.Labels, l)
+		if !ok || t.empty() {
+			continue
+		}
+		if source == "" || (t.breach > 0 && (best.breach == 0 || t.breach < best.breach)) {
+			best, source = t, "label "+l
+		}
+	}
+	if source != "" {
+		return best, source
+	}
+	if t, ok := lookupFold(c.Repos, pr.RepoName); ok && !t.empty() {
+		return t, "repo " + pr.RepoName
+	}
+	if t, ok := lookupFold(c.Repos, pr.RepoFullName); ok && !t.empty() {
+		return t, "repo " + pr.RepoFullName
+	}
+	if !c.SLAThresholds.empty() {
+		return c.SLAThresholds, "global"
+	}
+	return SLAThresholds{}, ""
+}
+
+// lookupFold finds the thresholds for a repo or label, ignoring case as
+// rule matching does. An exact match wins.
+func lookupFold(m map[string]SLAThresholds, key string) (SLAThresholds, bool) {
+	if t, ok := m[key]; ok {
+		return t, true
+	}
+	for k, t := range m {
+		if strings.EqualFold(k, key) {
+			return t, true
+		}
+	}
+	return SLAThresholds{}, false
+}
+
+// computeRequestedAt returns when my outstanding review request was made:
+// the latest ReviewRequestedEvent naming me or one of my teams. It returns
+// zero if there's no outstanding request or I've submitted a review since.
+// If the event has scrolled out of the fetched timeline, the PR's creation
+// time stands in for it.
+func computeRequestedAt(pr PRNode, me string, myTeams map[string]bool) time.Time {
+	if computeRequestKind(pr, me, myTeams) == RequestNone {
+		return time.Time{}
+	}
+	var at time.Time
+	for _, ev := range pr.TimelineItems.Nodes {
+		rr := ev.RequestedReviewer
+		if (rr.Login != "" && rr.Login == me) || (rr.Slug != "" && myTeams[rr.Slug]) {
+			if ev.CreatedAt.After(at) {
+				at = ev.CreatedAt
+			}
+		}
+	}
+	if at.IsZero() {
+		at = pr.CreatedAt
+	}
+	if last, _ := myDecidingReview(pr, me); last != nil && last.SubmittedAt.After(at) {
+		return time.Time{}
+	}
+	return at
+}
+
+// computeSLA classifies how long my review has been outstanding, counted in
+// working time when a calendar is configured.
+func computeSLA(pr ClassifiedPR, c SLAConfig, cal Calendar) SLAState {
+	if pr.RequestedAt.IsZero() || pr.IsDraft {
+		return SLANone
+	}
+	t, source := c.thresholdsFor(pr)
+	if source == "" {
+		return SLANone
+	}
+	elapsed := cal.since(pr.RequestedAt)
+	switch {
+	case t.breach > 0 && elapsed >= t.breach:
+		return SLABreach
+	case t.warn > 0 && elapsed >= t.warn:
+		return SLAWarn
+	}
+	return SLAOk
+}
+
+// explainSLA says which thresholds decided the PR's SLA state.
+func explainSLA(pr ClassifiedPR, c SLAConfig, cal Calendar) string {
+	if pr.RequestedAt.IsZero() || pr.IsDraft {
+		return "no outstanding review request"
+	}
+	t, source := c.thresholdsFor(pr)
+	if source == "" {
+		return "no SLA configured"
+	}
+	ago := "ago"
+	if cal.on {
+		ago = "of working time ago"
+	}
+	return fmt.Sprintf("%s: requested %s %s at %s (warn %s, breach %s, from %s config)",
+		pr.SLA, cal.since(pr.RequestedAt).Round(time.Minute), ago, fmtTime(pr.RequestedAt), orDash(t.Warn), orDash(t.Breach), source)
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
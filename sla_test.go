@@ -0,0 +1,140 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func mustSLA(t *testing.T, c SLAConfig) SLAConfig {
+	t.Helper()
+	if err := c.compile(); err != nil {
+		t.Fatalf("compile: %v", err)
+	}
+	return c
+}
+
+func withRequestedEvent(login, slug string, at time.Time) func(*PRNode) {
+	return func(pr *PRNode) {
+		ev := ReviewRequestedEventNode{CreatedAt: at}
+		ev.RequestedReviewer.Login = login
+		ev.RequestedReviewer.Slug = slug
+		pr.TimelineItems.Nodes = append(pr.TimelineItems.Nodes, ev)
+	}
+}
+
+func TestSLAConfig_ThresholdsFor(t *testing.T) {
+	c := mustSLA(t, SLAConfig{
+		SLAThresholds: SLAThresholds{Warn: "1d", Breach: "2d"},
+		Repos:         map[string]SLAThresholds{"billing": {Breach: "1d"}},
+		Labels: map[string]SLAThresholds{
+			"hotfix": {Warn: "1h", Breach: "4h"},
+			"urgent": {Breach: "2h"},
+		},
+	})
+	cases := []struct {
+		name   string
+		pr     ClassifiedPR
+		source string
+	}{
+		{"global", ClassifiedPR{RepoName: "web"}, "global"},
+		{"repo", ClassifiedPR{RepoName: "billing"}, "repo billing"},
+		{"label beats repo", ClassifiedPR{RepoName: "billing", Labels: []string{"hotfix"}}, "label hotfix"},
+		{"strictest label", ClassifiedPR{Labels: []string{"hotfix", "urgent"}}, "label urgent"},
+		{"repo in another case", ClassifiedPR{RepoName: "Billing"}, "repo Billing"},
+		{"label in another case", ClassifiedPR{Labels: []string{"HotFix"}}, "label HotFix"},
+	}
+	for _, tc := range cases {
+		if _, source := c.thresholdsFor(tc.pr); source != tc.source {
+			t.Errorf("%s: got source %q, want %q", tc.name, source, tc.source)
+		}
+	}
+
+	if _, source := (SLAConfig{}).thresholdsFor(ClassifiedPR{}); source != "" {
+		t.Errorf("expected no thresholds when unconfigured, got %q", source)
+	}
+}
+
+func TestComputeRequestedAt(t *testing.T) {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	first := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	second := time.Date(2025, 1, 4, 0, 0, 0, 0, time.UTC)
+	myTeams := map[string]bool{"backend": true}
+
+	pr := makePR(
+		withReviewRequest("me", "", false),
+		withRequestedEvent("me", "", first),
+		withRequestedEvent("someone", "", second),
+	)
+	if got := computeRequestedAt(pr, "me", myTeams); !got.Equal(first) {
+		t.Errorf("expected my request event time %v, got %v", first, got)
+	}
+
+	pr = makePR(
+		withReviewRequest("", "backend", false),
+		withRequestedEvent("", "backend", first),
+		withRequestedEvent("", "backend", second),
+	)
+	if got := computeRequestedAt(pr, "me", myTeams); !got.Equal(second) {
+		t.Errorf("expected latest team request %v, got %v", second, got)
+	}
+
+	pr = makePR(withReviewRequest("me", "", false))
+	if got := computeRequestedAt(pr, "me", myTeams); !got.Equal(created) {
+		t.Errorf("expected fallback to CreatedAt, got %v", got)
+	}
+
+	pr = makePR(withRequestedEvent("me", "", first))
+	if got := computeRequestedAt(pr, "me", myTeams); !got.IsZero() {
+		t.Errorf("expected zero without an outstanding request, got %v", got)
+	}
+
+	pr = makePR(
+		withReviewRequest("me", "", false),
+		withRequestedEvent("me", "", first),
+		withReview("me", "COMMENTED", second),
+	)
+	if got := computeRequestedAt(pr, "me", myTeams); !got.IsZero() {
+		t.Errorf("expected zero after I reviewed, got %v", got)
+	}
+}
+
+func TestComputeSLA(t *testing.T) {
+	c := mustSLA(t, SLAConfig{SLAThresholds: SLAThresholds{Warn: "1d", Breach: "2d"}})
+	now := time.Now()
+	cases := []struct {
+		name string
+		pr   ClassifiedPR
+		want SLAState
+	}{
+		{"not requested", ClassifiedPR{}, SLANone},
+		{"fresh", ClassifiedPR{RequestedAt: now.Add(-time.Hour)}, SLAOk},
+		{"warn", ClassifiedPR{RequestedAt: now.Add(-30 * time.Hour)}, SLAWarn},
+		{"breach", ClassifiedPR{RequestedAt: now.Add(-72 * time.Hour)}, SLABreach},
+		{"draft", ClassifiedPR{RequestedAt: now.Add(-72 * time.Hour), IsDraft: true}, SLANone},
+	}
+	for _, tc := range cases {
+		if got := computeSLA(tc.pr, c, Calendar{}); got != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.name, got, tc.want)
+		}
+	}
+	if got := computeSLA(ClassifiedPR{RequestedAt: now.Add(-72 * time.Hour)}, SLAConfig{}, Calendar{}); got != SLANone {
+		t.Errorf("expected SLANone without config, got %s", got)
+	}
+}
+
+func TestClassifyAll_SLABreachSortsUp(t *testing.T) {
+	c := mustSLA(t, SLAConfig{SLAThresholds: SLAThresholds{Breach: "1d"}})
+	old := time.Now().Add(-72 * time.Hour)
+	prs := []PRNode{
+		makePR(withAuthor("alice"), withReviewRequest("me", "", false), withRequestedEvent("me", "", time.Now()), withURL("https://github.com/org/repo/pull/1")),
+		makePR(withAuthor("bob"), withReviewRequest("", "backend", false), withRequestedEvent("", "backend", old), withURL("https://github.com/org/repo/pull/2")),
+	}
+	result := classifyAll(prs, "me", map[string]bool{"backend": true}, nil, nil, SortPriority, Config{SLA: c})
+	if result[0].Author != "bob" || result[0].SLA != SLABreach {
+		t.Errorf("expected breached team request first, got %s (%s)", result[0].Author, result[0].SLA)
+	}
+	if e := result[0].explain(Config{SLA: c}).SLA; !strings.HasPrefix(e, "breach: requested") {
+		t.Errorf("expected SLA explanation naming the breach, got %q", e)
+	}
+}
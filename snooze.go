@@ -0,0 +1,205 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Snooze choices land on these hours, local time.
+const (
+	snoozeMorning = 9
+	snoozeEvening = 18
+)
+
+// snoozeEndedRetention is how long a "snooze ended" marker waits to be
+// seen before it's dropped.
+const snoozeEndedRetention = 14 * 24 * time.Hour
+
+// prSnooze is a PR hidden until a set time.
+type prSnooze struct {
+	Until time.Time `json:"until"`
+	// Ref and Title describe the PR on the dismissals screen.
+	Ref   string `json:"ref"`
+	Title string `json:"title"`
+}
+
+// snoozeChoice is one of the quick choices offered after S.
+type snoozeChoice struct {
+	label string
+	until func(now time.Time) time.Time
+}
+
+// at returns day's date at hour o'clock, in day's location.
+func at(day time.Time, hour int) time.Time {
+	y, mo, d := day.Date()
+	return time.Date(y, mo, d, hour, 0, 0, 0, day.Location())
+}
+
+var snoozeChoices = []snoozeChoice{
+	{"1h", func(now time.Time) time.Time { return now.Add(time.Hour) }},
+	{"tonight", func(now time.Time) time.Time {
+		// Past the evening already, tonight is tomorrow's
+		if t := at(now, snoozeEvening); t.After(now) {
+			return t
+		}
+		return at(now.AddDate(0, 0, 1), snoozeEvening)
+	}},
+	{"tomorrow 9am", func(now time.Time) time.Time { return at(now.AddDate(0, 0, 1), snoozeMorning) }},
+	{"next Monday", func(now time.Time) time.Time {
+		days := (8 - int(now.Weekday())) % 7
+		if days == 0 {
+			days = 7
+		}
+		return at(now.AddDate(0, 0, days), snoozeMorning)
+	}},
+}
+
+// snoozePrompt lists the quick choices, numbered, then the custom date.
+func snoozePrompt(pr ClassifiedPR) string {
+	parts := make([]string, 0, len(snoozeChoices)+1)
+	for i, c := range snoozeChoices {
+		parts = append(parts, fmt.Sprintf("%d %s", i+1, c.label))
+	}
+	parts = append(parts, fmt.Sprintf("%d date…", len(snoozeChoices)+1))
+	return fmt.Sprintf("Snooze %s#%d: %s (any other key cancels)", pr.RepoName, pr.Number, strings.Join(parts, "  "))
+}
+
+// parseSnoozeUntil reads a custom snooze time: a date ("2026-01-05", at
+// 9am), a date and time ("2026-01-05 14:30"), or a duration from now
+// ("3h", "2d").
+func parseSnoozeUntil(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.ParseInLocation("2006-01-02 15:04", s, now.Location()); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", s, now.Location()); err == nil {
+		return at(t, snoozeMorning), nil
+	}
+	if d, err := parseAge(s); err == nil && d > 0 {
+		return now.Add(d), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid snooze time %q: use 2006-01-02, 2006-01-02 15:04 or a duration like 3h or 2d", s)
+}
+
+// fmtSnoozeUntil shows when a snooze ends, with the date only when it
+// isn't today.
+func fmtSnoozeUntil(t, now time.Time) string {
+	t = t.Local()
+	if y, m, d := t.Date(); y == now.Year() && m == now.Month() && d == now.Day() {
+		return t.Format("15:04")
+	}
+	return t.Format("Mon Jan 2 15:04")
+}
+
+// snooze hides pr until the given time.
+func (s *dismissStore) snooze(pr ClassifiedPR, until time.Time) {
+	s.Snoozes[pr.URL] = prSnooze{
+		Until: until,
+		Ref:   fmt.Sprintf("%s#%d", pr.RepoName, pr.Number),
+		Title: pr.Title,
+	}
+	delete(s.Woke, pr.URL)
+	s.dirty = true
+}
+
+// wake ends the snoozes that are due, marking their PRs "snooze ended"
+// until they're next seen, and returns how many ended.
+func (s *dismissStore) wake(now time.Time) int {
+	n := 0
+	for url, z := range s.Snoozes {
+		if now.Before(z.Until) {
+			continue
+		}
+		delete(s.Snoozes, url)
+		s.Woke[url] = z.Until
+		s.dirty = true
+		n++
+	}
+	return n
+}
+
+// acknowledge drops the "snooze ended" marker once the PR has been seen.
+func (s *dismissStore) acknowledge(url string) {
+	if _, ok := s.Woke[url]; ok {
+		delete(s.Woke, url)
+		s.dirty = true
+	}
+}
+
+// applySnoozes marks PRs whose snooze ended and that haven't been seen
+// since.
+func applySnoozes(items []ClassifiedPR, s *dismissStore) {
+	for i := range items {
+		_, items[i].SnoozeEnded = s.Woke[items[i].URL]
+	}
+}
+
+func snoozeTickCmd() tea.Cmd {
+	return tea.Tick(time.Minute, func(t time.Time) tea.Msg {
+		return snoozeTickMsg(t)
+	})
+}
+
+// snoozeTickMsg checks for ended snoozes between refreshes.
+type snoozeTickMsg time.Time
+
+// wakeSnoozes brings back PRs whose snooze has ended and says so.
+func (m *model) wakeSnoozes(now time.Time) {
+	n := m.dismissals.wake(now)
+	if n == 0 {
+		return
+	}
+	applySnoozes(m.items, m.dismissals)
+	applySnoozes(m.authorItems, m.dismissals)
+	m.statusMsg = fmt.Sprintf("%s back: snooze ended", countPRs(n))
+}
+
+// snoozeSelected snoozes the selected PR and moves off it.
+func (m *model) snoozeSelected(until time.Time) {
+	pr, ok := m.selectedPR()
+	if !ok {
+		return
+	}
+	m.dismissals.snooze(pr, until)
+	m.statusMsg = fmt.Sprintf("Snoozed %s#%d until %s", pr.RepoName, pr.Number, fmtSnoozeUntil(until, time.Now()))
+	if vis := m.rows(); m.cursor >= len(vis) && m.cursor > 0 {
+		m.cursor = len(vis) - 1
+	}
+}
+
+// updateSnoozeDate handles typing a custom snooze time: enter snoozes
+// until it, esc cancels.
+func (m model) updateSnoozeDate(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.snoozeTyping = false
+		m.statusMsg = "Cancelled"
+	case tea.KeyEnter:
+		m.snoozeTyping = false
+		if strings.TrimSpace(m.snoozeInput) == "" {
+			m.statusMsg = "Cancelled"
+			return m, nil
+		}
+		until, err := parseSnoozeUntil(m.snoozeInput, time.Now())
+		switch {
+		case err != nil:
+			m.statusMsg = err.Error()
+		case !until.After(time.Now()):
+			m.statusMsg = fmt.Sprintf("Snooze time %s is in the past", fmtSnoozeUntil(until, time.Now()))
+		default:
+			m.snoozeSelected(until)
+		}
+	case tea.KeyBackspace:
+		if r := []rune(m.snoozeInput); len(r) > 0 {
+			m.snoozeInput = string(r[:len(r)-1])
+		}
+	case tea.KeySpace:
+		m.snoozeInput += " "
+	case tea.KeyRunes:
+		m.snoozeInput += string(msg.Runes)
+	}
+	return m, nil
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSnoozeChoices(t *testing.T) {
+	loc := time.FixedZone("test", 2*60*60)
+	// Wednesday afternoon
+	now := time.Date(2026, 10, 14, 15, 30, 0, 0, loc)
+	want := []time.Time{
+		time.Date(2026, 10, 14, 16, 30, 0, 0, loc),
+		time.Date(2026, 10, 14, 18, 0, 0, 0, loc),
+		time.Date(2026, 10, 15, 9, 0, 0, 0, loc),
+		time.Date(2026, 10, 19, 9, 0, 0, 0, loc),
+	}
+	for i, c := range snoozeChoices {
+		if got := c.until(now); !got.Equal(want[i]) {
+			t.Errorf("%s: got %v, want %v", c.label, got, want[i])
+		}
+	}
+
+	// Late on a Monday, tonight is tomorrow's and next Monday a week on
+	late := time.Date(2026, 10, 19, 22, 0, 0, 0, loc)
+	if got := snoozeChoices[1].until(late); !got.Equal(time.Date(2026, 10, 20, 18, 0, 0, 0, loc)) {
+		t.Errorf("tonight after the evening: got %v", got)
+	}
+	if got := snoozeChoices[3].until(late); !got.Equal(time.Date(2026, 10, 26, 9, 0, 0, 0, loc)) {
+		t.Errorf("next Monday on a Monday: got %v", got)
+	}
+}
+
+func TestParseSnoozeUntil(t *testing.T) {
+	now := time.Date(2026, 10, 14, 15, 30, 0, 0, time.Local)
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{"2026-10-20", time.Date(2026, 10, 20, 9, 0, 0, 0, time.Local)},
+		{" 2026-10-20 14:15 ", time.Date(2026, 10, 20, 14, 15, 0, 0, time.Local)},
+		{"3h", now.Add(3 * time.Hour)},
+		{"2d", now.Add(48 * time.Hour)},
+	}
+	for _, tt := range tests {
+		got, err := parseSnoozeUntil(tt.in, now)
+		if err != nil || !got.Equal(tt.want) {
+			t.Errorf("parseSnoozeUntil(%q) = %v, %v; want %v", tt.in, got, err, tt.want)
+		}
+	}
+	for _, bad := range []string{"later", "-2h", "2026-13-01"} {
+		if _, err := parseSnoozeUntil(bad, now); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestDismissStore_Snooze(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dismissed.json")
+	s := newDismissStore(path)
+	pr := ClassifiedPR{URL: "u1", RepoName: "api", Number: 7, Title: "Fix"}
+	now := time.Now()
+	s.snooze(pr, now.Add(time.Hour))
+	if !s.hides(pr) {
+		t.Fatal("expected snoozed PR hidden")
+	}
+	if n := s.wake(now); n != 0 || !s.hides(pr) {
+		t.Error("expected snooze to hold before it ends")
+	}
+	entries := s.entries()
+	if len(entries) != 1 || entries[0].kind != "snooze" || entries[0].label != "api#7  Fix" {
+		t.Errorf("unexpected entries %+v", entries)
+	}
+
+	s.Woke["gone"] = now.Add(-snoozeEndedRetention - time.Hour)
+	if err := s.save(); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := loadDismissals(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := loaded.Woke["gone"]; ok {
+		t.Error("expected stale snooze-ended marker dropped")
+	}
+	if n := loaded.wake(now.Add(2 * time.Hour)); n != 1 || loaded.hides(pr) {
+		t.Fatalf("expected snooze ended, got %d", n)
+	}
+
+	items := []ClassifiedPR{pr}
+	applySnoozes(items, loaded)
+	if !items[0].SnoozeEnded || !strings.Contains(displayTitle(items[0]), "[snooze ended]") {
+		t.Errorf("expected snooze-ended marker, got %q", displayTitle(items[0]))
+	}
+	loaded.acknowledge(pr.URL)
+	applySnoozes(items, loaded)
+	if items[0].SnoozeEnded {
+		t.Error("expected marker cleared once seen")
+	}
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// linkStacks finds stacked PRs — a PR whose base branch is another listed
+// PR's head branch in the same repo, not a fork — and regroups items so
+// each stack appears as a tree at the position of its best-sorted member,
+// children following their parent. reviewed decides which members count towards a
+// stack's StackReviewed.
+func linkStacks(items []ClassifiedPR, reviewed func(ClassifiedPR) bool) []ClassifiedPR {
+	heads := make(map[string]int)
+	for i, pr := range items {
+		key := pr.RepoFullName + "\x00" + pr.HeadRef
+		if _, dup := heads[key]; !dup && pr.HeadRef != "" && !pr.ForkHead {
+			heads[key] = i
+		}
+	}
+	parent := make([]int, len(items))
+	for i, pr := range items {
+		parent[i] = -1
+		if p, ok := heads[pr.RepoFullName+"\x00"+pr.BaseRef]; ok && p != i && pr.BaseRef != "" {
+			parent[i] = p
+		}
+	}
+	// Break cycles (A on B on A) by cutting the link that closes the loop.
+	// A PR stacked on a loop it isn't part of keeps its link; the loop is
+	// cut when one of its own members comes round.
+	for i := range items {
+		seen := make(map[int]bool)
+		for p := parent[i]; p >= 0; p = parent[p] {
+			if p == i {
+				parent[i] = -1
+				break
+			}
+			if seen[p] {
+				break
+			}
+			seen[p] = true
+		}
+	}
+
+	children := make(map[int][]int)
+	for i, p := range parent {
+		if p >= 0 {
+			children[p] = append(children[p], i)
+		}
+	}
+	root := func(i int) int {
+		for parent[i] >= 0 {
+			i = parent[i]
+		}
+		return i
+	}
+
+	out := make([]ClassifiedPR, 0, len(items))
+	placed := make([]bool, len(items))
+	var walk func(i, depth, r int)
+	walk = func(i, depth, r int) {
+		placed[i] = true
+		pr := items[i]
+		if len(children[r]) > 0 {
+			pr.StackRoot = items[r].URL
+			pr.StackDepth = depth
+		}
+		if parent[i] >= 0 {
+			pr.StackParent = fmt.Sprintf("%s#%d", items[parent[i]].RepoName, items[parent[i]].Number)
+		}
+		out = append(out, pr)
+		for _, c := range children[i] {
+			walk(c, depth+1, r)
+		}
+	}
+	for i := range items {
+		if r := root(i); !placed[r] {
+			walk(r, 0, r)
+		}
+	}
+
+	// Aggregate review state per stack
+	size := make(map[string]int)
+	done := make(map[string]int)
+	for _, pr := range out {
+		if pr.StackRoot == "" {
+			continue
+		}
+		size[pr.StackRoot]++
+		if reviewed(pr) {
+			done[pr.StackRoot]++
+		}
+	}
+	for i := range out {
+		pr := &out[i]
+		if pr.StackRoot != "" {
+			pr.StackSize, pr.StackReviewed = size[pr.StackRoot], done[pr.StackRoot]
+		}
+	}
+	return out
+}
+
+// explainStack says where the PR sits in its stack.
+func explainStack(pr ClassifiedPR) string {
+	if pr.StackRoot == "" {
+		return "not stacked"
+	}
+	where := "bottom of"
+	if pr.StackParent != "" {
+		where = fmt.Sprintf("on %s (base %s) in", pr.StackParent, pr.BaseRef)
+	}
+	return fmt.Sprintf("%s a stack of %d PRs, %d reviewed", where, pr.StackSize, pr.StackReviewed)
+}
+
+// hasCurrentReview reports whether my review of a PR is up to date.
+func hasCurrentReview(pr ClassifiedPR) bool {
+	switch pr.MyReview {
+	case MyApproved, MyChanges, MyCommented:
+		return true
+	}
+	return false
+}
+
+// stackPrefix indents a stacked PR's title under its parent.
+func stackPrefix(pr ClassifiedPR) string {
+	if pr.StackDepth == 0 {
+		return ""
+	}
+	return strings.Repeat("  ", pr.StackDepth-1) + "└─ "
+}
+
+// stackSummary describes a stack's review progress, shown on its bottom PR.
+func stackSummary(pr ClassifiedPR) string {
+	if pr.StackRoot == "" || pr.StackDepth > 0 {
+		return ""
+	}
+	if pr.StackReviewed == pr.StackSize {
+		return fmt.Sprintf("[stack ✓ all %d reviewed]", pr.StackSize)
+	}
+	return fmt.Sprintf("[stack %d/%d reviewed]", pr.StackReviewed, pr.StackSize)
+}
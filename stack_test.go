@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func stackItem(num int, base, head string, review MyReviewIndicator) ClassifiedPR {
+	return ClassifiedPR{
+		RepoName: "repo", RepoFullName: "org/repo", Number: num,
+		URL:     fmt.Sprintf("https://github.com/org/repo/pull/%d", num),
+		BaseRef: base, HeadRef: head, MyReview: review,
+	}
+}
+
+func TestLinkStacks(t *testing.T) {
+	items := []ClassifiedPR{
+		stackItem(3, "part-2", "part-3", MyNone),
+		stackItem(9, "main", "unrelated", MyNone),
+		stackItem(1, "main", "part-1", MyApproved),
+		stackItem(2, "part-1", "part-2", MyApproved),
+	}
+	got := linkStacks(items, hasCurrentReview)
+
+	var order []int
+	for _, pr := range got {
+		order = append(order, pr.Number)
+	}
+	// The stack moves up to where its best-sorted member (#3) was
+	want := []int{1, 2, 3, 9}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("order = %v, want %v", order, want)
+		}
+	}
+	root := got[0].URL
+	for i, depth := range []int{0, 1, 2} {
+		if got[i].StackRoot != root || got[i].StackDepth != depth {
+			t.Errorf("#%d: root %q depth %d, want %q depth %d", got[i].Number, got[i].StackRoot, got[i].StackDepth, root, depth)
+		}
+		if got[i].StackSize != 3 || got[i].StackReviewed != 2 {
+			t.Errorf("#%d: %d/%d reviewed, want 2/3", got[i].Number, got[i].StackReviewed, got[i].StackSize)
+		}
+	}
+	if got[2].StackParent != "repo#2" {
+		t.Errorf("expected #3 stacked on repo#2, got %q", got[2].StackParent)
+	}
+	if got[3].StackRoot != "" {
+		t.Errorf("expected #9 not stacked, got root %q", got[3].StackRoot)
+	}
+	if s := stackSummary(got[0]); s != "[stack 2/3 reviewed]" {
+		t.Errorf("stackSummary = %q", s)
+	}
+	if p := stackPrefix(got[2]); p != "  └─ " {
+		t.Errorf("stackPrefix(depth 2) = %q", p)
+	}
+}
+
+func TestLinkStacks_OtherRepoNotLinked(t *testing.T) {
+	a := stackItem(1, "main", "feature", MyNone)
+	b := stackItem(2, "feature", "feature-2", MyNone)
+	b.RepoFullName = "org/other"
+	for _, pr := range linkStacks([]ClassifiedPR{a, b}, hasCurrentReview) {
+		if pr.StackRoot != "" {
+			t.Errorf("#%d: expected no stack across repos", pr.Number)
+		}
+	}
+}
+
+func TestLinkStacks_ForkHeadNotLinked(t *testing.T) {
+	fork := stackItem(1, "main", "main", MyNone)
+	fork.ForkHead = true
+	items := []ClassifiedPR{fork, stackItem(2, "main", "feature", MyNone)}
+	for _, pr := range linkStacks(items, hasCurrentReview) {
+		if pr.StackRoot != "" {
+			t.Errorf("#%d: expected no stack on a fork's main, got parent %q", pr.Number, pr.StackParent)
+		}
+	}
+}
+
+func TestLinkStacks_Cycle(t *testing.T) {
+	items := []ClassifiedPR{
+		stackItem(3, "a", "c", MyNone),
+		stackItem(1, "b", "a", MyNone),
+		stackItem(2, "a", "b", MyNone),
+	}
+	got := linkStacks(items, hasCurrentReview)
+	if len(got) != 3 {
+		t.Fatalf("expected all PRs kept, got %d", len(got))
+	}
+	// The loop is cut inside it; #3, stacked on the loop, keeps its link
+	for _, pr := range got {
+		if pr.Number == 3 && pr.StackParent != "repo#1" {
+			t.Errorf("expected #3 still stacked on repo#1, got %q", pr.StackParent)
+		}
+	}
+}
+
+func TestLinkStacks_AllReviewed(t *testing.T) {
+	items := []ClassifiedPR{
+		stackItem(1, "main", "part-1", MyApproved),
+		stackItem(2, "part-1", "part-2", MyCommented),
+	}
+	got := linkStacks(items, hasCurrentReview)
+	if s := stackSummary(got[0]); !strings.Contains(s, "all 2 reviewed") {
+		t.Errorf("stackSummary = %q, want all reviewed", s)
+	}
+	if s := stackSummary(got[1]); s != "" {
+		t.Errorf("expected no summary on a stacked PR, got %q", s)
+	}
+}
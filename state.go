@@ -0,0 +1,108 @@
+package main
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// stateDir returns where pr-patrol keeps what it remembers between
+// sessions: $XDG_STATE_HOME/pr-patrol, or ~/.local/state/pr-patrol. It
+// doesn't follow --config, so a config file kept elsewhere, say in a team
+// repo, doesn't collect personal state next to it.
+func stateDir() string {
+	if dir := os.Getenv("XDG_STATE_HOME"); filepath.IsAbs(dir) {
+		return filepath.Join(dir, "pr-patrol")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".local", "state", "pr-patrol")
+}
+
+// statePath returns the state file name in stateDir, or "" if there's no
+// home directory to keep it in.
+func statePath(name string) string {
+	dir := stateDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, name)
+}
+
+// readStateFile reads a state file. A missing file, or no path, gives nil
+// data and no error.
+func readStateFile(path string) ([]byte, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	return data, err
+}
+
+// writeStateFile replaces the state file at path with data through a
+// temporary file and a rename, so a crash mid-write can't leave it
+// truncated.
+func writeStateFile(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), path)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
+
+// saveDelay batches changes to the stores, such as the seen marks left
+// while moving through the list, into one write.
+const saveDelay = 2 * time.Second
+
+// saveStateMsg writes the stores that changed since the last save.
+type saveStateMsg struct{}
+
+func saveStateCmd() tea.Cmd {
+	return tea.Tick(saveDelay, func(time.Time) tea.Msg {
+		return saveStateMsg{}
+	})
+}
+
+// scheduleSave arranges for the stores to be saved shortly after they
+// change, rather than only on exit, so a crash loses little.
+func (m model) scheduleSave(cmd tea.Cmd) (tea.Model, tea.Cmd) {
+	if m.saveScheduled || !(m.seen.unsaved() || m.dismissals.unsaved()) {
+		return m, cmd
+	}
+	m.saveScheduled = true
+	return m, tea.Batch(cmd, saveStateCmd())
+}
+
+// saveState writes the changed stores, reporting a failure in the status
+// bar; the final save on exit tries again.
+func (m *model) saveState() {
+	m.saveScheduled = false
+	for _, err := range []error{m.seen.save(), m.dismissals.save()} {
+		if err != nil {
+			m.statusMsg = err.Error()
+		}
+	}
+}
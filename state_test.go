@@ -0,0 +1,44 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteStateFile(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "pr-patrol")
+	path := filepath.Join(dir, "seen.json")
+	for _, content := range []string{"first", "second"} {
+		if err := writeStateFile(path, []byte(content)); err != nil {
+			t.Fatal(err)
+		}
+		data, err := readStateFile(path)
+		if err != nil || string(data) != content {
+			t.Fatalf("read %q (%v), want %q", data, err, content)
+		}
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 {
+		t.Errorf("expected no temporary files left, got %v", entries)
+	}
+
+	if data, err := readStateFile(filepath.Join(dir, "missing.json")); data != nil || err != nil {
+		t.Errorf("expected a missing file to read as nothing, got %q (%v)", data, err)
+	}
+}
+
+func TestStatePath(t *testing.T) {
+	state := t.TempDir()
+	t.Setenv("XDG_STATE_HOME", state)
+	if got, want := statePath("seen.json"), filepath.Join(state, "pr-patrol", "seen.json"); got != want {
+		t.Errorf("statePath = %q, want %q", got, want)
+	}
+
+	home := t.TempDir()
+	t.Setenv("XDG_STATE_HOME", "relative")
+	t.Setenv("HOME", home)
+	if got, want := statePath("seen.json"), filepath.Join(home, ".local", "state", "pr-patrol", "seen.json"); got != want {
+		t.Errorf("statePath = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// TabConfig is one TUI tab: a named view of the same PRs with its own
+// filter and sort order.
+type TabConfig struct {
+	Name string `json:"name"`
+	// Mine lists my own PRs with the My PRs columns instead of PRs to
+	// review.
+	Mine bool `json:"mine,omitempty"`
+	// Sort is the tab's initial sort order; empty uses --sort.
+	Sort  string    `json:"sort,omitempty"`
+	Match RuleMatch `json:"match"`
+
+	sortMode SortMode
+}
+
+// maxTabs is how many tabs the number keys can reach.
+const maxTabs = 9
+
+// defaultTabs are used when the config sets none.
+var defaultTabs = []TabConfig{
+	{Name: "Needs review", Match: RuleMatch{Involvement: []string{string(InvRequested)}}},
+	{Name: "My PRs", Mine: true},
+	{Name: "Team", Match: RuleMatch{Request: []string{string(RequestTeam), string(RequestCodeOwnerTeam)}}},
+	{Name: "All"},
+}
+
+func (t *TabConfig) compile() error {
+	if t.Name == "" {
+		return errors.New("missing name")
+	}
+	if t.Sort != "" {
+		mode, err := parseSortMode(t.Sort)
+		if err != nil {
+			return err
+		}
+		t.sortMode = mode
+	}
+	return t.Match.compile()
+}
+
+// tabFilter is the filtering each tab keeps for itself: the toggles,
+// focus and search.
+type tabFilter struct {
+	involvement  InvolvementFilter // only PRs I'm involved in this way
+	hideCovered  bool              // hide team requests a teammate already reviewed
+	awaitingOnly bool              // only PRs with review threads waiting on my reply
+	breachedOnly bool              // only PRs past their review SLA
+	unseenOnly   bool              // only PRs with activity since I last saw them
+	focusRepo    string
+	focusStack   string // StackRoot of the focused stack
+	focusAuthor  string
+	searchQuery  string // current search filter text
+}
+
+// tabState is what a tab remembers while another one is active.
+type tabState struct {
+	sortMode SortMode
+	cursor   int
+	filter   tabFilter
+}
+
+// switchTab makes tab i active, saving the current tab's sort order,
+// cursor and filters and restoring tab i's.
+func (m *model) switchTab(i int) {
+	if i < 0 || i >= len(m.tabs) || i == m.tab {
+		return
+	}
+	m.tabStates[m.tab] = tabState{sortMode: m.sortMode, cursor: m.cursor, filter: m.tabFilter}
+	m.tab = i
+	st := m.tabStates[i]
+	m.cursor = st.cursor
+	m.tabFilter = st.filter
+	if st.sortMode != m.sortMode {
+		m.sortMode = st.sortMode
+		m.reclassify()
+	}
+	if n := len(m.rows()); m.cursor >= n {
+		m.cursor = max(n-1, 0)
+	}
+	m.statusMsg = ""
+}
+
+// mine reports whether the active tab lists my own PRs.
+func (m model) mine() bool {
+	return m.tabs[m.tab].Mine
+}
+
+// renderTabs renders the tab bar with each tab's PR count.
+func (m model) renderTabs() string {
+	parts := make([]string, len(m.tabs))
+	for i, t := range m.tabs {
+		label := fmt.Sprintf(" %d %s (%d) ", i+1, t.Name, len(m.visibleIn(i)))
+		if i == m.tab {
+			parts[i] = tabActiveStyle.Render(label)
+		} else {
+			parts[i] = helpStyle.Render(label)
+		}
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// CommentTemplate is a canned comment c can post on a PR.
+type CommentTemplate struct {
+	Name string `json:"name"`
+	// Body is a text/template executed with the PR, so it can use fields
+	// such as {{.Author}}, {{.RepoName}}, {{.Number}} and {{.Title}}.
+	Body string `json:"body"`
+	// Edit opens the rendered body in $EDITOR before posting.
+	Edit bool `json:"edit,omitempty"`
+
+	tmpl *template.Template
+}
+
+// defaultTemplates are used when the config sets none.
+var defaultTemplates = []CommentTemplate{
+	{Name: "@claude", Body: "@claude please review this PR"},
+}
+
+func (t *CommentTemplate) compile() error {
+	if t.Name == "" {
+		return errors.New("missing name")
+	}
+	if strings.TrimSpace(t.Body) == "" {
+		return errors.New("missing body")
+	}
+	tmpl, err := template.New(t.Name).Option("missingkey=error").Parse(t.Body)
+	if err != nil {
+		return err
+	}
+	t.tmpl = tmpl
+	return nil
+}
+
+func (t CommentTemplate) render(pr ClassifiedPR) (string, error) {
+	if t.tmpl == nil {
+		if err := t.compile(); err != nil {
+			return "", err
+		}
+	}
+	var b strings.Builder
+	if err := t.tmpl.Execute(&b, pr); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(b.String()), nil
+}
+
+// commentDraft is a rendered template awaiting confirmation.
+type commentDraft struct {
+	pr   ClassifiedPR
+	name string
+	body string
+}
+
+type commentEditedMsg struct {
+	draft commentDraft
+	err   error
+}
+
+func editCommentCmd(d commentDraft) tea.Cmd {
+	initial := fmt.Sprintf("%s\n\n%s\n# Edit the %q comment for %s#%d above this line:\n# %s\n# %s\n",
+		d.body, scissors, d.name, d.pr.RepoName, d.pr.Number, d.pr.Title, d.pr.URL)
+	return editTextCmd(initial, func(text string, err error) tea.Msg {
+		d.body = text
+		return commentEditedMsg{draft: d, err: err}
+	})
+}
+
+// recordComment adds a comment I just posted to the raw PR, as the next
+// fetch would, so a bot request shows straight away. It returns the PR's
+// URL, or "" if it isn't listed.
+func recordComment(prs []PRNode, me, repo string, number int, body string) string {
+	for i := range prs {
+		pr := &prs[i]
+		if pr.Repository.NameWithOwner != repo || pr.Number != number {
+			continue
+		}
+		c := CommentNode{Body: body, CreatedAt: time.Now()}
+		c.Author.Login = me
+		pr.Comments.Nodes = append(pr.Comments.Nodes, c)
+		pr.Comments.TotalCount++
+		return pr.URL
+	}
+	return ""
+}
+
+// commentTemplates returns the configured templates, or the default.
+func (m model) commentTemplates() []CommentTemplate {
+	if len(m.config.Templates) > 0 {
+		return m.config.Templates
+	}
+	return defaultTemplates
+}
+
+// pickTemplate renders template i for the selected PR and either opens it
+// in the editor or asks for confirmation.
+func (m *model) pickTemplate(i int) tea.Cmd {
+	pr, ok := m.selectedPR()
+	templates := m.commentTemplates()
+	if !ok || i < 0 || i >= len(templates) {
+		m.statusMsg = "Cancelled"
+		return nil
+	}
+	t := templates[i]
+	if prs := m.markedItems(); len(prs) > 0 {
+		m.bulkComment(t, prs)
+		return nil
+	}
+	body, err := t.render(pr)
+	if err != nil {
+		m.statusMsg = fmt.Sprintf("Template %q: %v", t.Name, err)
+		return nil
+	}
+	d := commentDraft{pr: pr, name: t.Name, body: body}
+	if t.Edit {
+		return editCommentCmd(d)
+	}
+	m.confirmComment(d)
+	return nil
+}
+
+func (m *model) confirmComment(d commentDraft) {
+	m.confirmingComment = &d
+	m.statusMsg = fmt.Sprintf("%sPost %s on %s#%d? Press c to confirm",
+		botWarning(d.pr, d.body, m.config.Bot), d.name, d.pr.RepoName, d.pr.Number)
+}
+
+// templatePrompt lists the templates for the picker.
+func templatePrompt(templates []CommentTemplate) string {
+	parts := make([]string, len(templates))
+	for i, t := range templates {
+		parts[i] = fmt.Sprintf("%d %s", i+1, t.Name)
+	}
+	return "Comment: " + strings.Join(parts, ", ") + " (any other key cancels)"
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCommentTemplateRender(t *testing.T) {
+	tmpl := CommentTemplate{Name: "ping", Body: "Friendly ping @{{.Author}} — {{.RepoName}}#{{.Number}} is waiting\n"}
+	if err := tmpl.compile(); err != nil {
+		t.Fatal(err)
+	}
+	got, err := tmpl.render(ClassifiedPR{Author: "alice", RepoName: "api", Number: 12})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Friendly ping @alice — api#12 is waiting"; got != want {
+		t.Errorf("render = %q, want %q", got, want)
+	}
+
+	// Unknown fields fail when rendered, not silently
+	bad := CommentTemplate{Name: "bad", Body: "{{.Nope}}"}
+	if err := bad.compile(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := bad.render(ClassifiedPR{}); err == nil {
+		t.Error("expected error for unknown field")
+	}
+}
+
+func TestParseConfig_Templates(t *testing.T) {
+	cfg, err := parseConfig([]byte(`{"templates": [{"name": "rebase", "body": "Please rebase {{.Title}}", "edit": true}]}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.Templates) != 1 || cfg.Templates[0].tmpl == nil || !cfg.Templates[0].Edit {
+		t.Errorf("templates not compiled: %+v", cfg.Templates)
+	}
+	for _, bad := range []string{
+		`{"templates": [{"body": "hi"}]}`,
+		`{"templates": [{"name": "x", "body": " "}]}`,
+		`{"templates": [{"name": "x", "body": "{{.Author"}]}`,
+	} {
+		if _, err := parseConfig([]byte(bad)); err == nil {
+			t.Errorf("expected error for %s", bad)
+		}
+	}
+}
+
+func TestTemplatePrompt(t *testing.T) {
+	got := templatePrompt([]CommentTemplate{{Name: "@claude"}, {Name: "ping"}})
+	if !strings.Contains(got, "1 @claude, 2 ping") {
+		t.Errorf("unexpected prompt %q", got)
+	}
+}
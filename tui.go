@@ -1,10 +1,12 @@
 package main
 
 import (
+	"cmp"
 	"fmt"
 	"hash/fnv"
 	"os/exec"
 	"runtime"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -31,9 +33,12 @@ var (
 	styleDim  = lipgloss.NewStyle().Faint(true)
 	styleWhite  = lipgloss.NewStyle().Foreground(lipgloss.Color("7"))
 	styleOrange = lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	styleMagenta = lipgloss.NewStyle().Foreground(lipgloss.Color("5"))
 
 	selBg     = lipgloss.NewStyle().Background(lipgloss.Color("238"))
 	helpStyle = lipgloss.NewStyle().Faint(true)
+	tabActiveStyle = lipgloss.NewStyle().Bold(true).Reverse(true)
+	groupStyle     = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("4"))
 
 	// Palette of distinguishable ANSI-256 colors for repo/author hashing.
 	namePalette = []lipgloss.Color{
@@ -56,24 +61,55 @@ func nameColor(name string) lipgloss.Style {
 	return lipgloss.NewStyle().Foreground(namePalette[h.Sum32()%uint32(len(namePalette))])
 }
 
+// truncateWidth cuts s to fit in width terminal cells, ending it with "…"
+// when anything was cut. Titles carry wide and multi-byte runes (✉, └─,
+// CJK), so this counts cells, not bytes.
+func truncateWidth(s string, width int) string {
+	if lipgloss.Width(s) <= width {
+		return s
+	}
+	var b strings.Builder
+	used := 0
+	for _, r := range s {
+		w := lipgloss.Width(string(r))
+		if used+w > width-1 {
+			break
+		}
+		b.WriteRune(r)
+		used += w
+	}
+	return b.String() + "…"
+}
+
 type model struct {
 	items        []ClassifiedPR
+	authorItems  []ClassifiedPR // my own PRs, for tabs with Mine set
 	cursor       int
-	dismissed       map[string]bool
-	dismissedRepos  map[string]bool
-	dismissedAuthors map[string]bool
+	tabs         []TabConfig
+	tabStates    []tabState // sort order, cursor and filters per tab
+	tab          int        // active tab
+	dismissals      *dismissStore   // dismissed PRs, repos and authors, across sessions
+	dismissedRepos  map[string]bool // from --dismiss-repos, this session only
 	cols      colWidths
+	authorCols authorWidths
 	width     int
 	height    int
 
-	rawPRs  []PRNode
-	me      string
-	myTeams map[string]bool
+	rawPRs      []PRNode
+	me          string
+	myTeams     map[string]bool
+	teamMembers map[string][]string
+
+	config Config
+	seen   *seenStore // when I last saw each PR, across sessions
 
-	showAssigned bool
+	notifications map[string]Notification // GitHub notification threads by PR URL
+
+	tabFilter         // the active tab's filters
+	rawAge       bool // wall-clock ages even with a working calendar
 	sortMode     SortMode
-	focusRepo    string
-	focusAuthor  string
+	groupBy      GroupBy
+	collapsed    map[string]bool // collapsed groups, by group-by mode and key
 
 	loading      bool
 	loadingCount int
@@ -83,34 +119,68 @@ type model struct {
 	limit        int
 	errMsg       string
 	showHelp     bool
+	showExplain  bool // explain overlay for the selected PR (x)
+	showDetail   bool // detail pane for the selected PR (p)
+	showDismissals  bool // dismissals screen (X)
+	dismissCursor   int
+	confirmingClear bool // awaiting C again to restore everything on the X screen
+	saveScheduled   bool // a saveStateMsg is on its way
+	detailScroll int
+	details      map[string]*detailEntry // fetched detail per PR URL
 	statusMsg    string
 
-	confirmingComment bool // awaiting second 'c' to confirm @claude comment
+	choosingTemplate  bool          // awaiting a template number after 'c'
+	confirmingComment *commentDraft // awaiting second 'c' to confirm
+	choosingReview    bool         // awaiting a/r/c after 'e'
+	confirmingReview  *reviewDraft // awaiting second 'e' to submit
+
+	marked         map[string]bool // PR URLs marked for bulk actions
+	rangeFrom      int             // cursor where a V range started, or -1
+	confirmingBulk *bulkAction     // awaiting the action's key again
+	labeling       bool            // typing a label after 'L'
+	labelInput     string
+
+	choosingSnooze bool // awaiting a snooze choice after 'S'
+	snoozeTyping   bool // typing a custom snooze time
+	snoozeInput    string
 
 	searching    bool   // search mode active (entered via '/')
-	searchQuery  string // current search filter text
 }
 
 type modelConfig struct {
 	rawPRs         []PRNode
 	me             string
 	myTeams        map[string]bool
-	showAssigned bool
+	teamMembers    map[string][]string
+	involvement  InvolvementFilter
+	startInMyPRs bool // open on the first My PRs tab
+	hideCovered  bool
+	awaitingOnly bool
+	breachedOnly bool
+	unseenOnly   bool
+	rawAge       bool
 	sortMode     SortMode
+	groupBy      GroupBy
 	loading        bool
 	org            string
 	limit          int
 	dismissedRepos map[string]bool
+	dismissals     *dismissStore
+	config         Config
+	seen           *seenStore
 }
 
 type fetchPageMsg struct {
-	prs     []PRNode
-	me      string
-	myTeams map[string]bool
-	done    bool
-	fetchID int
-	ch      <-chan []PRNode
-	errCh   <-chan error
+	prs         []PRNode
+	me          string
+	myTeams     map[string]bool
+	teamMembers map[string][]string
+	// notifications is only set on the first page; nil on later ones
+	notifications map[string]Notification
+	done        bool
+	fetchID     int
+	ch          <-chan []PRNode
+	errCh       <-chan error
 }
 
 type fetchErrMsg struct {
@@ -118,16 +188,65 @@ type fetchErrMsg struct {
 	fetchID int
 }
 
+type notificationDoneMsg struct {
+	url          string
+	unsubscribed bool
+	err          error
+}
+
+// markReadCmd marks a notification thread read, unsubscribing first if
+// asked to.
+func markReadCmd(pr ClassifiedPR, unsubscribe bool) tea.Cmd {
+	return func() tea.Msg {
+		if unsubscribe {
+			if err := unsubscribeThread(pr.NotificationThread); err != nil {
+				return notificationDoneMsg{url: pr.URL, unsubscribed: true, err: err}
+			}
+		}
+		err := markThreadRead(pr.NotificationThread)
+		return notificationDoneMsg{url: pr.URL, unsubscribed: unsubscribe, err: err}
+	}
+}
+
+type interdiffMsg struct {
+	repo     string
+	number   int
+	url      string
+	fallback bool
+}
+
+func interdiffCmd(pr ClassifiedPR) tea.Cmd {
+	return func() tea.Msg {
+		url, fallback := resolveInterdiff(pr)
+		return interdiffMsg{repo: pr.RepoName, number: pr.Number, url: url, fallback: fallback}
+	}
+}
+
+type detailMsg struct {
+	url    string
+	detail PRDetail
+	err    error
+}
+
+func fetchDetailCmd(pr ClassifiedPR) tea.Cmd {
+	return func() tea.Msg {
+		node, err := fetchPRDetail(pr.RepoFullName, pr.Number)
+		org, _, _ := strings.Cut(pr.RepoFullName, "/")
+		return detailMsg{url: pr.URL, detail: buildDetail(node, org), err: err}
+	}
+}
+
 type commentPostedMsg struct {
 	repo   string
 	number int
+	body   string
 	err    error
 }
 
 func postCommentCmd(repo string, number int, body string) tea.Cmd {
 	return func() tea.Msg {
 		err := postComment(repo, number, body)
-		return commentPostedMsg{repo: repo, number: number, err: err}
+		return commentPostedMsg{repo: repo, number: number, body: body, err: err}
 	}
 }
 
@@ -148,10 +267,11 @@ func startFetchCmd(org string, limit int, fetchID int) tea.Cmd {
 	return func() tea.Msg {
 		var me string
 		var myTeams map[string]bool
+		var notifications map[string]Notification
 		var userErr, teamsErr error
 
 		var wg sync.WaitGroup
-		wg.Add(2)
+		wg.Add(3)
 		go func() {
 			defer wg.Done()
 			me, userErr = fetchCurrentUser()
@@ -163,6 +283,14 @@ func startFetchCmd(org string, limit int, fetchID int) tea.Cmd {
 				myTeams = make(map[string]bool)
 			}
 		}()
+		go func() {
+			defer wg.Done()
+			// Best-effort: tokens without notification access just get no dots
+			notifications, _ = fetchNotifications(org)
+			if notifications == nil {
+				notifications = make(map[string]Notification)
+			}
+		}()
 		wg.Wait()
 
 		if userErr != nil {
@@ -175,6 +303,9 @@ func startFetchCmd(org string, limit int, fetchID int) tea.Cmd {
 			errCh <- fetchOpenPRsStreaming(org, limit, prCh)
 		}()
 
+		// Resolve team rosters while the first page is in flight
+		teamMembers := fetchAllTeamMembers(org, myTeams)
+
 		// Wait for first page
 		prs, ok := <-prCh
 		if !ok {
@@ -183,19 +314,21 @@ func startFetchCmd(org string, limit int, fetchID int) tea.Cmd {
 				return fetchErrMsg{err: err, fetchID: fetchID}
 			}
 			return fetchPageMsg{
-				me: me, myTeams: myTeams,
+				me: me, myTeams: myTeams, teamMembers: teamMembers,
+				notifications: notifications,
 				done: true, fetchID: fetchID,
 			}
 		}
 		return fetchPageMsg{
-			prs: prs, me: me, myTeams: myTeams,
+			prs: prs, me: me, myTeams: myTeams, teamMembers: teamMembers,
+			notifications: notifications,
 			done: false, fetchID: fetchID, ch: prCh, errCh: errCh,
 		}
 	}
 }
 
 // waitForPageCmd reads the next page from the channel.
-func waitForPageCmd(ch <-chan []PRNode, errCh <-chan error, me string, myTeams map[string]bool, fetchID int) tea.Cmd {
+func waitForPageCmd(ch <-chan []PRNode, errCh <-chan error, me string, myTeams map[string]bool, teamMembers map[string][]string, fetchID int) tea.Cmd {
 	return func() tea.Msg {
 		prs, ok := <-ch
 		if !ok {
@@ -206,12 +339,12 @@ func waitForPageCmd(ch <-chan []PRNode, errCh <-chan error, me string, myTeams m
 				}
 			}
 			return fetchPageMsg{
-				me: me, myTeams: myTeams,
+				me: me, myTeams: myTeams, teamMembers: teamMembers,
 				done: true, fetchID: fetchID,
 			}
 		}
 		return fetchPageMsg{
-			prs: prs, me: me, myTeams: myTeams,
+			prs: prs, me: me, myTeams: myTeams, teamMembers: teamMembers,
 			done: false, fetchID: fetchID, ch: ch, errCh: errCh,
 		}
 	}
@@ -230,24 +363,99 @@ func filterDismissedRepos(prs []ClassifiedPR, repos map[string]bool) []Classifie
 	return out
 }
 
+// filterUnseen keeps PRs with activity since I last saw them.
+func filterUnseen(prs []ClassifiedPR) []ClassifiedPR {
+	var out []ClassifiedPR
+	for _, pr := range prs {
+		if pr.Unseen != "" {
+			out = append(out, pr)
+		}
+	}
+	return out
+}
+
+// filterCovered drops unreviewed team requests that a teammate has already
+// reviewed for the team.
+func filterCovered(prs []ClassifiedPR) []ClassifiedPR {
+	var out []ClassifiedPR
+	for _, pr := range prs {
+		if pr.TeamSatisfiedBy == "" || pr.MyReview != MyNone {
+			out = append(out, pr)
+		}
+	}
+	return out
+}
+
+// filterSLABreached keeps only PRs whose review request is past its SLA.
+func filterSLABreached(prs []ClassifiedPR) []ClassifiedPR {
+	var out []ClassifiedPR
+	for _, pr := range prs {
+		if pr.SLA == SLABreach {
+			out = append(out, pr)
+		}
+	}
+	return out
+}
+
+// filterAwaitingReply keeps only PRs with review threads waiting on my reply.
+func filterAwaitingReply(prs []ClassifiedPR) []ClassifiedPR {
+	var out []ClassifiedPR
+	for _, pr := range prs {
+		if pr.ThreadsAwaitingMe > 0 {
+			out = append(out, pr)
+		}
+	}
+	return out
+}
+
 func newModel(cfg modelConfig) model {
 	dismissedRepos := cfg.dismissedRepos
 	if dismissedRepos == nil {
 		dismissedRepos = make(map[string]bool)
 	}
 	m := model{
-		dismissed:        make(map[string]bool),
+		dismissals:       cmp.Or(cfg.dismissals, newDismissStore("")),
 		dismissedRepos:   dismissedRepos,
-		dismissedAuthors: make(map[string]bool),
+		details:          make(map[string]*detailEntry),
+		marked:           make(map[string]bool),
+		rangeFrom:        -1,
 		rawPRs:     cfg.rawPRs,
 		me:         cfg.me,
 		myTeams:    cfg.myTeams,
-		showAssigned: cfg.showAssigned,
+		teamMembers: cfg.teamMembers,
+		config:     cfg.config,
+		rawAge:     cfg.rawAge,
+		seen:       cfg.seen,
+		tabFilter: tabFilter{
+			involvement:  cfg.involvement,
+			hideCovered:  cfg.hideCovered,
+			awaitingOnly: cfg.awaitingOnly,
+			breachedOnly: cfg.breachedOnly,
+			unseenOnly:   cfg.unseenOnly,
+		},
 		sortMode:     cfg.sortMode,
+		groupBy:      cfg.groupBy,
+		collapsed:    make(map[string]bool),
 		loading:    cfg.loading,
 		org:        cfg.org,
 		limit:      cfg.limit,
 	}
+	if m.sortMode == "" {
+		m.sortMode = SortPriority
+	}
+	m.tabs = cfg.config.Tabs
+	if len(m.tabs) == 0 {
+		m.tabs = defaultTabs
+	}
+	m.tabStates = make([]tabState, len(m.tabs))
+	for i, t := range m.tabs {
+		m.tabStates[i].sortMode = cmp.Or(t.sortMode, m.sortMode)
+		m.tabStates[i].filter = m.tabFilter
+		if cfg.startInMyPRs && t.Mine && !m.tabs[m.tab].Mine {
+			m.tab = i
+		}
+	}
+	m.sortMode = m.tabStates[m.tab].sortMode
 	if !m.loading {
 		m.reclassify()
 	}
@@ -255,19 +463,20 @@ func newModel(cfg modelConfig) model {
 }
 
 func (m *model) reclassify() {
-	var filter func(PRNode) bool
-	if m.showAssigned {
-		me, teams := m.me, m.myTeams
-		filter = func(pr PRNode) bool {
-			return isRequestedReviewer(pr, me, teams)
-		}
-	}
-	m.items = classifyAll(m.rawPRs, m.me, m.myTeams, filter, m.sortMode)
+	m.items = classifyAll(m.rawPRs, m.me, m.myTeams, m.teamMembers, nil, m.sortMode, m.config)
+	applySeen(m.items, m.seen)
+	applyNotifications(m.items, m.notifications)
+	applySnoozes(m.items, m.dismissals)
 	m.cols = computeColumns(m.items)
+	m.authorItems = classifyAllAuthor(m.rawPRs, m.me, m.sortMode, m.config)
+	applySeen(m.authorItems, m.seen)
+	applyNotifications(m.authorItems, m.notifications)
+	applySnoozes(m.authorItems, m.dismissals)
+	m.authorCols = computeAuthorColumns(m.authorItems)
 }
 
 func (m model) Init() tea.Cmd {
-	cmds := []tea.Cmd{tea.HideCursor}
+	cmds := []tea.Cmd{tea.HideCursor, snoozeTickCmd()}
 	if m.loading {
 		cmds = append(cmds, startFetchCmd(m.org, m.limit, m.fetchID), tickCmd())
 	}
@@ -275,6 +484,11 @@ func (m model) Init() tea.Cmd {
 }
 
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	updated, cmd := m.update(msg)
+	return updated.(model).scheduleSave(cmd)
+}
+
+func (m model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case fetchPageMsg:
 		if msg.fetchID != m.fetchID {
@@ -283,21 +497,29 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.errMsg = ""
 		m.me = msg.me
 		m.myTeams = msg.myTeams
+		m.teamMembers = msg.teamMembers
+		if msg.notifications != nil {
+			m.notifications = msg.notifications
+		}
 		if msg.prs != nil {
 			m.rawPRs = msg.prs
 			m.loadingCount = len(msg.prs)
 			m.reclassify()
 		}
 		// Clamp cursor
-		vis := m.visibleItems()
+		vis := m.rows()
 		if m.cursor >= len(vis) && m.cursor > 0 {
 			m.cursor = len(vis) - 1
 		}
 		if msg.done {
 			m.loading = false
+			if n := m.dismissals.expire(slices.Concat(m.items, m.authorItems)); n > 0 {
+				m.statusMsg = fmt.Sprintf("%s back after their dismissal ended", countPRs(n))
+			}
+			m.wakeSnoozes(time.Now())
 			return m, nil
 		}
-		return m, waitForPageCmd(msg.ch, msg.errCh, msg.me, msg.myTeams, msg.fetchID)
+		return m, waitForPageCmd(msg.ch, msg.errCh, msg.me, msg.myTeams, msg.teamMembers, msg.fetchID)
 	case fetchErrMsg:
 		if msg.fetchID != m.fetchID {
 			return m, nil
@@ -309,10 +531,82 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.spinnerFrame = (m.spinnerFrame + 1) % len(spinnerFrames)
 			return m, tickCmd()
 		}
+	case saveStateMsg:
+		m.saveState()
+	case snoozeTickMsg:
+		m.wakeSnoozes(time.Time(msg))
+		return m, snoozeTickCmd()
+	case notificationDoneMsg:
+		verb := "mark read"
+		if msg.unsubscribed {
+			verb = "unsubscribe"
+		}
+		if msg.err != nil {
+			m.statusMsg = fmt.Sprintf("Failed to %s: %v", verb, msg.err)
+			return m, nil
+		}
+		if n, ok := m.notifications[msg.url]; ok {
+			n.Unread = false
+			m.notifications[msg.url] = n
+		}
+		applyNotifications(m.items, m.notifications)
+		if msg.unsubscribed {
+			m.statusMsg = "Unsubscribed and marked read"
+		} else {
+			m.statusMsg = "Marked read"
+		}
+	case interdiffMsg:
+		_ = openBrowser(msg.url)
+		if msg.fallback {
+			m.statusMsg = fmt.Sprintf("%s#%d: reviewed commit is no longer on the branch; opened the files view", msg.repo, msg.number)
+		} else {
+			m.statusMsg = fmt.Sprintf("Opened changes to %s#%d since your review", msg.repo, msg.number)
+		}
+	case commentEditedMsg:
+		switch {
+		case msg.err != nil:
+			m.statusMsg = fmt.Sprintf("Editor failed: %v", msg.err)
+		case msg.draft.body == "":
+			m.statusMsg = "Cancelled: empty comment"
+		default:
+			m.confirmComment(msg.draft)
+		}
+	case reviewEditedMsg:
+		d := msg.draft
+		switch {
+		case msg.err != nil:
+			m.statusMsg = fmt.Sprintf("Editor failed: %v", msg.err)
+		case d.body == "" && d.event != ReviewApprove:
+			m.statusMsg = "Cancelled: empty review"
+		default:
+			m.confirmingReview = &d
+			m.statusMsg = fmt.Sprintf("Submit %s on %s#%d? Press e to confirm", d.event.verb(), d.pr.RepoName, d.pr.Number)
+		}
+	case reviewSubmittedMsg:
+		d := msg.draft
+		if msg.err != nil {
+			m.statusMsg = fmt.Sprintf("Failed to submit %s on %s#%d: %v", d.event.verb(), d.pr.RepoName, d.pr.Number, msg.err)
+			return m, nil
+		}
+		recordReview(m.rawPRs, m.me, d)
+		m.reclassify()
+		m.selectURL(d.pr.URL)
+		m.statusMsg = fmt.Sprintf("Submitted %s on %s#%d", d.event.verb(), d.pr.RepoName, d.pr.Number)
+		if msg.pending {
+			m.statusMsg += " (with your pending review's comments)"
+		}
+	case detailMsg:
+		m.details[msg.url] = &detailEntry{detail: msg.detail, err: msg.err}
+	case bulkDoneMsg:
+		m.bulkDone(msg)
 	case commentPostedMsg:
 		if msg.err != nil {
 			m.statusMsg = fmt.Sprintf("Failed to comment on %s#%d: %v", msg.repo, msg.number, msg.err)
 		} else {
+			if url := recordComment(m.rawPRs, m.me, msg.repo, msg.number, msg.body); url != "" {
+				m.reclassify()
+				m.selectURL(url)
+			}
 			m.statusMsg = fmt.Sprintf("Commented on %s#%d", msg.repo, msg.number)
 		}
 	case tea.KeyMsg:
@@ -345,17 +639,81 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+		if m.labeling {
+			return m.updateLabel(msg)
+		}
+		if m.snoozeTyping {
+			return m.updateSnoozeDate(msg)
+		}
+
+		// Snooze picker: a number picks when, the last one a custom time
+		if m.choosingSnooze {
+			m.choosingSnooze = false
+			k := msg.String()
+			switch {
+			case len(k) == 1 && k[0] >= '1' && int(k[0]-'1') < len(snoozeChoices):
+				m.snoozeSelected(snoozeChoices[k[0]-'1'].until(time.Now()))
+			case k == fmt.Sprint(len(snoozeChoices)+1):
+				m.snoozeTyping = true
+				m.snoozeInput = ""
+			default:
+				m.statusMsg = "Cancelled"
+			}
+			return m, nil
+		}
+
+		// Bulk confirmation: the action's key again runs it on every marked PR
+		if m.confirmingBulk != nil {
+			a := *m.confirmingBulk
+			m.confirmingBulk = nil
+			if msg.String() == a.confirmKey() {
+				return m, m.runBulk(a)
+			}
+			m.statusMsg = "Cancelled"
+			return m, nil
+		}
+
+		// Template picker: a number picks a template, anything else cancels
+		if m.choosingTemplate {
+			m.choosingTemplate = false
+			k := msg.String()
+			if len(k) == 1 && k[0] >= '1' && k[0] <= '9' {
+				return m, m.pickTemplate(int(k[0] - '1'))
+			}
+			m.statusMsg = "Cancelled"
+			return m, nil
+		}
+
 		// Confirmation mode: second 'c' confirms, anything else cancels
-		if m.confirmingComment {
-			m.confirmingComment = false
+		if m.confirmingComment != nil {
+			d := *m.confirmingComment
+			m.confirmingComment = nil
 			if msg.String() == "c" {
-				if pr, ok := m.selectedPR(); ok {
-					m.statusMsg = fmt.Sprintf("Commenting on %s#%d...", pr.RepoName, pr.Number)
-					return m, postCommentCmd(pr.RepoFullName, pr.Number, "@claude please review this PR")
-				}
-			} else {
-				m.statusMsg = "Cancelled"
+				m.statusMsg = fmt.Sprintf("Commenting on %s#%d...", d.pr.RepoName, d.pr.Number)
+				return m, postCommentCmd(d.pr.RepoFullName, d.pr.Number, d.body)
 			}
+			m.statusMsg = "Cancelled"
+			return m, nil
+		}
+
+		// Review mode: pick the kind of review, then confirm what was written
+		if m.choosingReview {
+			m.choosingReview = false
+			event, chosen := reviewKeys[msg.String()]
+			if pr, ok := m.selectedPR(); ok && chosen {
+				return m, editReviewCmd(pr, event)
+			}
+			m.statusMsg = "Cancelled"
+			return m, nil
+		}
+		if m.confirmingReview != nil {
+			d := *m.confirmingReview
+			m.confirmingReview = nil
+			if msg.String() == "e" {
+				m.statusMsg = fmt.Sprintf("Submitting %s on %s#%d...", d.event.verb(), d.pr.RepoName, d.pr.Number)
+				return m, submitReviewCmd(d, m.me)
+			}
+			m.statusMsg = "Cancelled"
 			return m, nil
 		}
 
@@ -363,37 +721,158 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.showHelp = false
 			return m, nil
 		}
+		if m.showExplain {
+			m.showExplain = false
+			return m, nil
+		}
+		if m.showDetail {
+			return m.updateDetail(msg)
+		}
+		if m.showDismissals {
+			return m.updateDismissals(msg)
+		}
 		switch msg.String() {
 		case "q", "ctrl+c":
 			return m, tea.Quit
 		case "?":
 			m.showHelp = true
 			return m, nil
+		case "x":
+			if _, ok := m.selectedPR(); ok {
+				m.showExplain = true
+			}
+			return m, nil
+		case "p":
+			m.markSelectedSeen()
+			return m, m.openDetail()
 		case "j", "down":
-			vis := m.visibleItems()
+			vis := m.rows()
 			if m.cursor < len(vis)-1 {
 				m.cursor++
 			}
+			m.markSelectedSeen()
 		case "k", "up":
 			if m.cursor > 0 {
 				m.cursor--
 			}
+			m.markSelectedSeen()
 		case "enter":
+			if prs := m.markedItems(); len(prs) > 0 {
+				m.confirmBulk(bulkAction{kind: bulkOpen, prs: prs})
+				return m, nil
+			}
+			m.markSelectedSeen()
 			if pr, ok := m.selectedPR(); ok {
 				_ = openBrowser(pr.URL)
+				if pr.Unread && m.config.Notifications.MarkReadOnOpen {
+					return m, markReadCmd(pr, false)
+				}
+			}
+		case "v":
+			if pr, ok := m.selectedPR(); ok {
+				_ = openBrowser(pr.URL + "/files")
 			}
+			m.markSelectedSeen()
+		case "i":
+			pr, ok := m.selectedPR()
+			switch {
+			case !ok:
+			case !isStaleReview(pr.MyReview):
+				m.statusMsg = "No new commits since your review"
+			default:
+				m.markSelectedSeen()
+				m.statusMsg = fmt.Sprintf("Opening changes to %s#%d since your review...", pr.RepoName, pr.Number)
+				return m, interdiffCmd(pr)
+			}
+		case "m", "M":
+			pr, ok := m.selectedPR()
+			switch {
+			case !ok:
+			case pr.NotificationThread == "":
+				m.statusMsg = "No notification thread for this PR"
+			case msg.String() == "M":
+				m.statusMsg = fmt.Sprintf("Unsubscribing from %s#%d...", pr.RepoName, pr.Number)
+				return m, markReadCmd(pr, true)
+			default:
+				m.statusMsg = fmt.Sprintf("Marking %s#%d read...", pr.RepoName, pr.Number)
+				return m, markReadCmd(pr, false)
+			}
+		case "n":
+			m.unseenOnly = !m.unseenOnly
+			if m.unseenOnly {
+				m.statusMsg = "Showing only PRs with activity since you last saw them"
+			} else {
+				m.statusMsg = "Showing all PRs"
+			}
+			m.cursor = 0
+		case "tab":
+			m.switchTab((m.tab + 1) % len(m.tabs))
+		case "shift+tab":
+			m.switchTab((m.tab + len(m.tabs) - 1) % len(m.tabs))
+		case "1", "2", "3", "4", "5", "6", "7", "8", "9":
+			m.switchTab(int(msg.String()[0] - '1'))
 		case "a":
-			m.showAssigned = !m.showAssigned
-			m.reclassify()
+			i := slices.Index(involvementFilters, m.involvement)
+			m.involvement = involvementFilters[(i+1)%len(involvementFilters)]
+			switch m.involvement {
+			case InvolveAll:
+				m.statusMsg = "Showing all PRs"
+			case InvolveAny:
+				m.statusMsg = "Showing only PRs you're involved in"
+			default:
+				m.statusMsg = "Showing only PRs where you're involved as " + m.involvement.String()
+			}
+			m.cursor = 0
+		case "t":
+			m.hideCovered = !m.hideCovered
+			if m.hideCovered {
+				m.statusMsg = "Hiding team requests a teammate already reviewed"
+			} else {
+				m.statusMsg = "Showing team requests a teammate already reviewed"
+			}
+			m.cursor = 0
+		case "w":
+			m.awaitingOnly = !m.awaitingOnly
+			if m.awaitingOnly {
+				m.statusMsg = "Showing only threads waiting on your reply"
+			} else {
+				m.statusMsg = "Showing all threads"
+			}
+			m.cursor = 0
+		case "b":
+			m.breachedOnly = !m.breachedOnly
+			if m.breachedOnly {
+				m.statusMsg = "Showing only PRs past their review SLA"
+			} else {
+				m.statusMsg = "Showing all PRs regardless of SLA"
+			}
 			m.cursor = 0
+		case "h":
+			m.rawAge = !m.rawAge
+			if m.rawAge {
+				m.statusMsg = "Showing wall-clock ages"
+			} else if m.config.Calendar.on {
+				m.statusMsg = "Showing ages in working time"
+			} else {
+				m.statusMsg = "Showing ages (no working calendar configured)"
+			}
 		case "f":
-			if pr, ok := m.selectedPR(); ok && m.focusRepo == pr.RepoName {
-				m.focusRepo = ""
-				m.statusMsg = ""
-			} else if ok {
+			// Stacked PRs cycle stack → repo → off; others toggle repo
+			pr, ok := m.selectedPR()
+			switch {
+			case !ok:
+			case pr.StackRoot != "" && m.focusStack == "" && m.focusRepo == "":
+				m.focusStack = pr.StackRoot
+				m.focusAuthor = ""
+				m.statusMsg = fmt.Sprintf("Focus: stack of %d PRs (%d reviewed)", pr.StackSize, pr.StackReviewed)
+			case m.focusStack != "" || m.focusRepo != pr.RepoName:
+				m.focusStack = ""
 				m.focusRepo = pr.RepoName
 				m.focusAuthor = ""
 				m.statusMsg = fmt.Sprintf("Focus: repo %s", pr.RepoName)
+			default:
+				m.focusRepo = ""
+				m.statusMsg = ""
 			}
 			m.cursor = 0
 		case "F":
@@ -403,38 +882,52 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			} else if ok {
 				m.focusAuthor = pr.Author
 				m.focusRepo = ""
+				m.focusStack = ""
 				m.statusMsg = fmt.Sprintf("Focus: author %s", pr.Author)
 			}
 			m.cursor = 0
 		case "R":
-			m.dismissed = make(map[string]bool)
+			// Kept dismissals and snoozes stay; X clears those. Every
+			// toggle goes off and involvement back to all.
 			m.dismissedRepos = make(map[string]bool)
-			m.dismissedAuthors = make(map[string]bool)
-			m.focusRepo = ""
-			m.focusAuthor = ""
-			m.searchQuery = ""
+			m.tabFilter = tabFilter{}
 			m.statusMsg = "Reset all filters"
 			m.cursor = 0
 		case "esc":
-			if m.searchQuery != "" {
+			if len(m.marked) > 0 || m.rangeFrom >= 0 {
+				m.clearMarks()
+				m.statusMsg = "Cleared marks"
+			} else if m.searchQuery != "" {
 				m.searchQuery = ""
 				m.cursor = 0
-			} else if m.focusRepo != "" || m.focusAuthor != "" {
+			} else if m.focusRepo != "" || m.focusStack != "" || m.focusAuthor != "" {
 				m.focusRepo = ""
+				m.focusStack = ""
 				m.focusAuthor = ""
 				m.statusMsg = ""
 				m.cursor = 0
 			}
-		case "s":
-			if m.sortMode == SortDate {
-				m.sortMode = SortPriority
+		case "g":
+			next := (slices.Index(groupModes, m.groupBy) + 1) % len(groupModes)
+			m.groupBy = groupModes[next]
+			if m.groupBy == GroupNone {
+				m.statusMsg = "Not grouping"
 			} else {
-				m.sortMode = SortDate
+				m.statusMsg = "Grouping by " + m.groupBy.String()
 			}
+			m.cursor = 0
+		case "z":
+			m.toggleGroup()
+		case "Z":
+			m.toggleAllGroups()
+		case "s":
+			next := (slices.Index(sortModes, m.sortMode) + 1) % len(sortModes)
+			m.sortMode = sortModes[next]
 			m.reclassify()
 			m.cursor = 0
 		case "r":
 			if m.org != "" {
+				clear(m.details)
 				m.fetchID++
 				m.loading = true
 				m.loadingCount = 0
@@ -442,36 +935,79 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.errMsg = ""
 				return m, tea.Batch(startFetchCmd(m.org, m.limit, m.fetchID), tickCmd())
 			}
+		case " ":
+			m.toggleMark()
+		case "V":
+			m.markRange()
+		case "ctrl+a":
+			m.markAll()
+		case "L":
+			if prs := m.bulkTargets(); len(prs) > 0 {
+				m.labeling = true
+				m.labelInput = ""
+			}
 		case "d":
+			if prs := m.markedItems(); len(prs) > 0 {
+				m.confirmBulk(bulkAction{kind: bulkDismiss, prs: prs})
+			} else if pr, ok := m.selectedPR(); ok {
+				m.dismissals.dismissPR(pr, m.config.Dismiss, false)
+				vis := m.rows()
+				if m.cursor >= len(vis) && m.cursor > 0 {
+					m.cursor--
+				}
+			}
+		case "u":
 			if pr, ok := m.selectedPR(); ok {
-				m.dismissed[pr.URL] = true
-				vis := m.visibleItems()
+				m.dismissals.dismissPR(pr, m.config.Dismiss, true)
+				m.statusMsg = fmt.Sprintf("Dismissed %s#%d until new commits or comments", pr.RepoName, pr.Number)
+				vis := m.rows()
 				if m.cursor >= len(vis) && m.cursor > 0 {
 					m.cursor--
 				}
 			}
+		case "S":
+			if pr, ok := m.selectedPR(); ok {
+				m.choosingSnooze = true
+				m.statusMsg = snoozePrompt(pr)
+			}
+		case "X":
+			m.showDismissals = true
+			m.dismissCursor = 0
 		case "D":
 			if pr, ok := m.selectedPR(); ok {
-				m.dismissedRepos[pr.RepoName] = true
+				m.dismissals.dismissRepo(pr.RepoName)
 				m.statusMsg = fmt.Sprintf("Dismissed repo %s", pr.RepoName)
-				vis := m.visibleItems()
+				vis := m.rows()
 				if m.cursor >= len(vis) && m.cursor > 0 {
 					m.cursor = len(vis) - 1
 				}
 			}
 		case "A":
 			if pr, ok := m.selectedPR(); ok {
-				m.dismissedAuthors[pr.Author] = true
+				m.dismissals.dismissAuthor(pr.Author)
 				m.statusMsg = fmt.Sprintf("Dismissed author %s", pr.Author)
-				vis := m.visibleItems()
+				vis := m.rows()
 				if m.cursor >= len(vis) && m.cursor > 0 {
 					m.cursor = len(vis) - 1
 				}
 			}
 		case "c":
+			if _, ok := m.selectedPR(); ok {
+				templates := m.commentTemplates()
+				if len(templates) == 1 {
+					return m, m.pickTemplate(0)
+				}
+				m.choosingTemplate = true
+				m.statusMsg = templatePrompt(templates)
+			}
+		case "e":
 			if pr, ok := m.selectedPR(); ok {
-				m.confirmingComment = true
-				m.statusMsg = fmt.Sprintf("Post @claude review on %s#%d? Press c to confirm", pr.RepoName, pr.Number)
+				if pr.IsAuthor {
+					m.statusMsg = "You can't review your own PR"
+				} else {
+					m.choosingReview = true
+					m.statusMsg = fmt.Sprintf("Review %s#%d: a approve, r request changes, c comment (any other key cancels)", pr.RepoName, pr.Number)
+				}
 			}
 		case "/":
 			m.searching = true
@@ -497,16 +1033,37 @@ func (m model) View() string {
 	if m.showHelp {
 		return m.renderLegend()
 	}
+	if m.showExplain {
+		if pr, ok := m.selectedPR(); ok {
+			return m.renderExplain(pr)
+		}
+	}
+	if m.showDetail {
+		if pr, ok := m.selectedPR(); ok {
+			return m.renderDetail(pr)
+		}
+	}
+	if m.showDismissals {
+		return m.renderDismissals()
+	}
 
-	vis := m.visibleItems()
+	var tabBar string
+	if len(m.tabs) > 1 {
+		tabBar = m.renderTabs() + "\n"
+	}
+	vis := m.rows()
 	if len(vis) == 0 && !m.loading {
-		return "No PRs match current filters. Press R to reset, Esc to clear focus.\n"
+		return tabBar + "No PRs match current filters. Press R to reset, Esc to clear focus, tab to switch tabs.\n"
 	}
 
 	var b strings.Builder
-	// Layout: 1 header + N items + 1 status + 1 help = height
-	// Reserve 3 lines for header, status bar, and help bar
+	b.WriteString(tabBar)
+	// Layout: [1 tab bar] + 1 header + N items + 1 status + 1 help = height
+	// Reserve 3 lines for header, status bar, and help bar, plus the tab bar
 	maxLines := m.height - 3
+	if tabBar != "" {
+		maxLines--
+	}
 	if maxLines <= 0 {
 		maxLines = len(vis)
 	}
@@ -516,11 +1073,22 @@ func (m model) View() string {
 	if m.sortMode == SortDate {
 		ageLabel = "act"
 	}
-	headerLine := fmt.Sprintf("I O C S %-*s  %-*s  %4s  %s",
+	headerLine := fmt.Sprintf("I O C S T   %-*s  %-*s  %4s  %-2s  %s",
 		m.cols.repo, "repo",
 		m.cols.author, "author",
 		ageLabel,
+		"sz",
 		"title")
+	if m.mine() {
+		w := m.authorCols
+		headerLine = fmt.Sprintf("I O C S T   %-*s  %4s  %-*s  %-*s  %-*s  %s",
+			w.repo, "repo",
+			ageLabel,
+			w.ready, "merge",
+			w.pending, "waiting on",
+			w.changes, "changes by",
+			"title")
+	}
 	b.WriteString(helpStyle.Render(headerLine))
 	b.WriteString("\n")
 
@@ -536,8 +1104,27 @@ func (m model) View() string {
 	itemsRendered := end - start
 
 	for i := start; i < end; i++ {
-		pr := vis[i]
+		pr := vis[i].pr
 		selected := i == m.cursor
+		if vis[i].header != "" {
+			header := groupHeader(vis[i])
+			if selected {
+				header = groupStyle.Inherit(selBg).Render(header)
+				if pad := m.width - lipgloss.Width(header); pad > 0 {
+					header += selBg.Render(strings.Repeat(" ", pad))
+				}
+			} else {
+				header = groupStyle.Render(header)
+			}
+			b.WriteString(header)
+			b.WriteString("\n")
+			continue
+		}
+		if m.mine() {
+			b.WriteString(m.authorRow(pr, selected))
+			b.WriteString("\n")
+			continue
+		}
 		var bg *lipgloss.Style
 		if selected {
 			bg = &selBg
@@ -550,20 +1137,17 @@ func (m model) View() string {
 		if m.sortMode == SortDate {
 			ageTime = pr.LastActivity
 		}
-		ageCol := fmt.Sprintf("%4s", formatAge(ageTime))
+		ageCol := fmt.Sprintf("%4s", m.formatAge(ageTime))
+		sizeCol := fmt.Sprintf("%-2s", pr.Size)
 
 		// Build plain line for truncation check, then colorized version for display
-		plainLine := fmt.Sprintf("%s %s  %s  %s  %s", "       ", repoCol, authorCol, ageCol, pr.Title)
-		titleText := pr.Title
-		if m.width > 0 && len(plainLine) > m.width {
+		title := m.markPrefix(pr) + stackPrefix(pr) + displayTitle(pr)
+		plainLine := fmt.Sprintf("%s %s  %s  %s  %s  %s", "           ", repoCol, authorCol, ageCol, sizeCol, title)
+		titleText := title
+		if lineWidth := lipgloss.Width(plainLine); m.width > 0 && lineWidth > m.width {
 			// Truncate title to fit
-			overhead := len(plainLine) - len(pr.Title)
-			maxTitle := m.width - overhead - 1
-			if maxTitle > 0 && maxTitle < len(pr.Title) {
-				titleText = pr.Title[:maxTitle] + "…"
-			} else if maxTitle <= 0 {
-				titleText = "…"
-			}
+			overhead := lineWidth - lipgloss.Width(title)
+			titleText = truncateWidth(title, m.width-overhead)
 		}
 
 		var coloredRepo, coloredAuthor, line string
@@ -576,14 +1160,15 @@ func (m model) View() string {
 				coloredAuthor = nameColor(pr.Author).Inherit(selBg).Render(authorCol)
 			}
 			sep := selBg.Render("  ")
-			ageRendered := styleDim.Inherit(selBg).Render(ageCol)
+			ageRendered := ageStyle(pr).Inherit(selBg).Render(ageCol)
+			sizeRendered := sizeStyle(pr.Size).Inherit(selBg).Render(sizeCol)
 			var titleRendered string
 			if pr.IsDraft {
 				titleRendered = styleDim.Inherit(selBg).Render(titleText)
 			} else {
 				titleRendered = selBg.Render(titleText)
 			}
-			line = indicators + selBg.Render(" ") + coloredRepo + sep + coloredAuthor + sep + ageRendered + sep + titleRendered
+			line = indicators + selBg.Render(" ") + coloredRepo + sep + coloredAuthor + sep + ageRendered + sep + sizeRendered + sep + titleRendered
 			// Pad to full width
 			if m.width > 0 {
 				lineLen := lipgloss.Width(line)
@@ -595,12 +1180,14 @@ func (m model) View() string {
 			coloredRepo = styleDim.Render(repoCol)
 			coloredAuthor = styleDim.Render(authorCol)
 			ageRendered := styleDim.Render(ageCol)
-			line = fmt.Sprintf("%s %s  %s  %s  %s", indicators, coloredRepo, coloredAuthor, ageRendered, styleDim.Render(titleText))
+			sizeRendered := styleDim.Render(sizeCol)
+			line = fmt.Sprintf("%s %s  %s  %s  %s  %s", indicators, coloredRepo, coloredAuthor, ageRendered, sizeRendered, styleDim.Render(titleText))
 		} else {
 			coloredRepo = nameColor(pr.RepoName).Render(repoCol)
 			coloredAuthor = nameColor(pr.Author).Render(authorCol)
-			ageRendered := styleDim.Render(ageCol)
-			line = fmt.Sprintf("%s %s  %s  %s  %s", indicators, coloredRepo, coloredAuthor, ageRendered, titleText)
+			ageRendered := ageStyle(pr).Render(ageCol)
+			sizeRendered := sizeStyle(pr.Size).Render(sizeCol)
+			line = fmt.Sprintf("%s %s  %s  %s  %s  %s", indicators, coloredRepo, coloredAuthor, ageRendered, sizeRendered, titleText)
 		}
 		b.WriteString(line)
 		b.WriteString("\n")
@@ -615,32 +1202,65 @@ func (m model) View() string {
 	}
 
 	// Help bar
-	sortLabel := "sort:priority"
-	if m.sortMode == SortDate {
-		sortLabel = "sort:date"
+	sortLabel := "sort:" + string(m.sortMode)
+	groupLabel := "group:" + m.groupBy.String()
+	commentLabel := "comment"
+	if templates := m.commentTemplates(); len(templates) == 1 {
+		commentLabel = templates[0].Name
 	}
-	assignedLabel := "assigned:off"
-	if m.showAssigned {
-		assignedLabel = "assigned:on"
+	involvesLabel := "involves:" + m.involvement.String()
+	coveredLabel := "covered:shown"
+	if m.hideCovered {
+		coveredLabel = "covered:hidden"
+	}
+	awaitingLabel := "waiting:off"
+	if m.awaitingOnly {
+		awaitingLabel = "waiting:on"
+	}
+	unseenLabel := "new:all"
+	if m.unseenOnly {
+		unseenLabel = "new:only"
+	}
+	breachLabel := "sla:all"
+	if m.breachedOnly {
+		breachLabel = "sla:breached"
+	}
+	ageKindLabel := "age:wall"
+	if m.config.Calendar.on && !m.rawAge {
+		ageKindLabel = "age:work"
 	}
 	focusLabel := "focus:off"
-	if m.focusRepo != "" {
+	if m.focusStack != "" {
+		focusLabel = "focus:stack"
+	} else if m.focusRepo != "" {
 		focusLabel = "focus:" + m.focusRepo
 	} else if m.focusAuthor != "" {
 		focusLabel = "focus:" + m.focusAuthor
 	}
+	markLabel := "mark"
+	if n := len(m.markedItems()); n > 0 {
+		markLabel = fmt.Sprintf("mark:%d", n)
+	}
 	searchLabel := "search"
 	if m.searchQuery != "" && !m.searching {
 		searchLabel = "search:" + m.searchQuery
 	}
 	help := helpStyle.Render(fmt.Sprintf(
-		"j/k: navigate  enter: open  d/D/A: dismiss  f/F: %s  /: %s  a: %s  s: %s  c: @claude  r/R: refresh/reset  ?: legend  q: quit",
-		focusLabel, searchLabel, assignedLabel, sortLabel,
+		"tab/1-9: tabs  j/k: navigate  enter/v/i: open/files/since review  m/M: read/unsub  space/V/ctrl+a: %s  L: label  d/u/D/A/X: dismiss  S: snooze  f/F: %s  /: %s  a: %s  t: %s  w: %s  b: %s  n: %s  h: %s  s: %s  g: %s  z/Z: fold  e: review  c: %s  p: details  x: explain  r/R: refresh/reset  ?: legend  q: quit",
+		markLabel, focusLabel, searchLabel, involvesLabel, coveredLabel, awaitingLabel, breachLabel, unseenLabel, ageKindLabel, sortLabel, groupLabel, commentLabel,
 	))
 	if m.searching {
 		b.WriteString(styleCyan.Render("/") + m.searchQuery + styleCyan.Render("▎"))
 		b.WriteString("\n")
 		b.WriteString(helpStyle.Render("enter: keep filter  esc: clear  type to search"))
+	} else if m.snoozeTyping {
+		b.WriteString(styleCyan.Render("Snooze until: ") + m.snoozeInput + styleCyan.Render("▎"))
+		b.WriteString("\n")
+		b.WriteString(helpStyle.Render("2006-01-02, 2006-01-02 15:04 or 3h/2d  enter: snooze  esc: cancel"))
+	} else if m.labeling {
+		b.WriteString(styleCyan.Render(fmt.Sprintf("Label %s: ", countPRs(len(m.bulkTargets())))) + m.labelInput + styleCyan.Render("▎"))
+		b.WriteString("\n")
+		b.WriteString(helpStyle.Render("enter: add label  esc: cancel"))
 	} else if m.loading {
 		spin := styleCyan.Render(spinnerFrames[m.spinnerFrame])
 		var loadText string
@@ -670,9 +1290,12 @@ func (m model) renderLegend() string {
 	b.WriteString(fmt.Sprintf("  %s  You approved\n", styleGreen.Render("✓")))
 	b.WriteString(fmt.Sprintf("  %s  You requested changes\n", styleRed.Render("✗")))
 	b.WriteString(fmt.Sprintf("  %s  You left review comments\n", styleYellow.Render("◆")))
+	b.WriteString(fmt.Sprintf("  %s  Your review is unsubmitted (press v to finish)\n", styleOrange.Render("✎")))
 	b.WriteString(fmt.Sprintf("  %s  No review yet\n", styleDim.Render("·")))
-	b.WriteString(fmt.Sprintf("  %s  Codeowner review needed\n", styleOrange.Render("·")))
-	b.WriteString("  Color: bright = current, gray = stale\n")
+	b.WriteString(fmt.Sprintf("  %s  Review requested from you directly\n", styleMagenta.Render("•")))
+	b.WriteString(fmt.Sprintf("  %s  Codeowner review needed (via your team)\n", styleOrange.Render("·")))
+	b.WriteString(fmt.Sprintf("  %s  Review requested from one of your teams\n", styleWhite.Render("·")))
+	b.WriteString("  Color: bright = current, gray = stale (i: changes since your review)\n")
 	b.WriteString("\n")
 	b.WriteString("O — Others' Reviews:\n")
 	b.WriteString(fmt.Sprintf("  %s  All approved\n", styleGreen.Render("✓")))
@@ -683,6 +1306,7 @@ func (m model) renderLegend() string {
 	b.WriteString("C — Comments:\n")
 	b.WriteString(fmt.Sprintf("  %s  You commented\n", styleCyan.Render("●")))
 	b.WriteString(fmt.Sprintf("  %s  Others commented\n", styleWhite.Render("○")))
+	b.WriteString(fmt.Sprintf("  %s  You or your team were @-mentioned since you last commented\n", styleMagenta.Render("@")))
 	b.WriteString(fmt.Sprintf("  %s  No comments\n", styleDim.Render("·")))
 	b.WriteString("  Color: bright = fresh, gray = stale\n")
 	b.WriteString("\n")
@@ -693,20 +1317,82 @@ func (m model) renderLegend() string {
 	b.WriteString(fmt.Sprintf("  %s  Merge conflict\n", styleOrange.Render("!")))
 	b.WriteString(fmt.Sprintf("  %s  No status checks\n", styleDim.Render("·")))
 	b.WriteString("\n")
+	b.WriteString("Age — colored by review SLA:\n")
+	b.WriteString(fmt.Sprintf("  %s  Past the warning threshold\n", styleYellow.Render("3d")))
+	b.WriteString(fmt.Sprintf("  %s  Past the breach threshold\n", styleRed.Render("5d")))
+	b.WriteString("  Counted in working time when a calendar is configured (h: wall-clock)\n")
+	b.WriteString("\n")
+	b.WriteString("Size — lines changed (see sizes in config):\n")
+	b.WriteString(fmt.Sprintf("  %s  Extra small / small\n", sizeStyle(SizeXS).Render("XS S")))
+	b.WriteString(fmt.Sprintf("  %s     Medium\n", sizeStyle(SizeM).Render("M")))
+	b.WriteString(fmt.Sprintf("  %s     Large\n", sizeStyle(SizeL).Render("L")))
+	b.WriteString(fmt.Sprintf("  %s    Extra large\n", sizeStyle(SizeXL).Render("XL")))
+	b.WriteString("\n")
+	b.WriteString("Stacks — PRs based on another PR's branch are indented under it:\n")
+	b.WriteString("  └─ feature part 2   stacked on the PR above\n")
+	b.WriteString("  [stack 1/3 reviewed] / [stack ✓ all 3 reviewed] on the bottom PR\n")
+	b.WriteString("\n")
+	b.WriteString("Title — ✉ marks an unread GitHub notification (m: mark read)\n")
+	b.WriteString(fmt.Sprintf("Title — [bot review requested / in progress / done]: where the last %s request stands\n", m.config.Bot.withDefaults().Trigger))
+	b.WriteString("Title — ⏰ … [snooze ended]: a snoozed PR is back (until you next select it)\n")
+	b.WriteString("Title — [new] or [since last seen: 2 commits, 1 comment]:\n")
+	b.WriteString("  Activity since you last selected or opened the PR (kept across sessions)\n")
+	b.WriteString("\n")
+	b.WriteString("T — Review Threads:\n")
+	b.WriteString(fmt.Sprintf("  %s  Threads you're in where someone replied after you\n", styleCyan.Render("↩")))
+	b.WriteString(fmt.Sprintf("  %s  Unresolved threads\n", styleWhite.Render("◌")))
+	b.WriteString(fmt.Sprintf("  %s  No unresolved threads\n", styleDim.Render("·")))
+	b.WriteString("\n")
+	b.WriteString("My PRs tab:\n")
+	b.WriteString("  merge       First blocker: draft, conflicts, ci failing, changes,\n")
+	b.WriteString("              needs review, ci running — or ready\n")
+	b.WriteString("  waiting on  Reviewers and teams whose review is still requested\n")
+	b.WriteString("  changes by  Reviewers whose latest review requests changes\n")
+	b.WriteString("\n")
 	b.WriteString("Keys:\n")
+	b.WriteString("  tab     Next tab (shift+tab: previous)\n")
+	b.WriteString("  1-9     Switch to tab N\n")
 	b.WriteString("  j/k     Navigate up/down\n")
 	b.WriteString("  enter   Open PR in browser\n")
-	b.WriteString("  d       Dismiss current PR (hide it)\n")
+	b.WriteString("  v       Open PR's files view (finish a pending review)\n")
+	b.WriteString("  e       Review: a approve, r request changes, c comment; write it\n")
+	b.WriteString("          in $EDITOR, then press e again to submit\n")
+	b.WriteString("  p       Details: description, reviewers, checks, timeline\n")
+	b.WriteString("          (j/k scroll, J/K next/prev PR, esc closes)\n")
+	b.WriteString("  i       Open only the changes since your (stale) review\n")
+	b.WriteString("  m       Mark the PR's GitHub notification read\n")
+	b.WriteString("  M       Unsubscribe from the PR's notifications (and mark read)\n")
+	b.WriteString("  space   Mark/unmark PR for bulk actions (● before the title)\n")
+	b.WriteString("  V       Mark a range: press at one end, move, press again\n")
+	b.WriteString("  ctrl+a  Mark all visible PRs (again: unmark)\n")
+	b.WriteString("          With marks, d/enter/c act on every marked PR after one\n")
+	b.WriteString("          confirmation; esc clears the marks\n")
+	b.WriteString("  L       Add a label to the marked PRs (or the current one)\n")
+	b.WriteString("  d       Dismiss current PR (hide it; see dismiss in config)\n")
+	b.WriteString("  u       Dismiss current PR until new commits or comments\n")
 	b.WriteString("  D       Dismiss entire repo\n")
 	b.WriteString("  A       Dismiss author (e.g. dependabot)\n")
-	b.WriteString("  R       Reset all filters (dismissals, focus, search)\n")
-	b.WriteString("  f       Focus on repo of selected PR (toggle)\n")
+	b.WriteString("  S       Snooze current PR: 1h, tonight, tomorrow 9am, next Monday\n")
+	b.WriteString("          or a date; it comes back marked [snooze ended]\n")
+	b.WriteString("  X       List dismissals and snoozes (kept across sessions) and\n")
+	b.WriteString("          restore them (C restores all)\n")
+	b.WriteString("  R       Reset all filters (focus, search, involvement, toggles)\n")
+	b.WriteString("  f       Focus on stack, then repo, of selected PR (cycle)\n")
 	b.WriteString("  F       Focus on author of selected PR (toggle)\n")
-	b.WriteString("  Esc     Clear focus / cancel search\n")
+	b.WriteString("  Esc     Clear marks / focus / cancel search\n")
 	b.WriteString("  /       Search by title, repo, or author\n")
-	b.WriteString("  a       Toggle showing only PRs assigned to you\n")
-	b.WriteString("  s       Toggle sort: priority vs date\n")
-	b.WriteString("  c       Post @claude review comment (press twice to confirm)\n")
+	b.WriteString("  a       Cycle involvement: all, requested, any, assignee, mentioned,\n")
+	b.WriteString("          participant, author\n")
+	b.WriteString("  t       Toggle hiding team requests a teammate already reviewed\n")
+	b.WriteString("  w       Toggle showing only PRs with threads waiting on your reply\n")
+	b.WriteString("  b       Toggle showing only PRs past their review SLA\n")
+	b.WriteString("  n       Toggle showing only PRs with activity since you last saw them\n")
+	b.WriteString("  h       Toggle age: working time vs wall-clock\n")
+	b.WriteString("  s       Cycle sort: priority, date, small first, quick wins\n")
+	b.WriteString("  g       Cycle grouping: none, repo, author, priority, status, team\n")
+	b.WriteString("  z       Collapse/expand the group under the cursor (Z: all)\n")
+	b.WriteString("  c       Post a comment template (pick one, then press c to confirm)\n")
+	b.WriteString("  x       Explain the selected PR's indicators and sort position\n")
 	b.WriteString("  r       Refresh data from GitHub\n")
 	b.WriteString("  q       Quit\n")
 	b.WriteString("\n")
@@ -714,20 +1400,88 @@ func (m model) renderLegend() string {
 	return b.String()
 }
 
+func (m model) renderExplain(pr ClassifiedPR) string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("%s  %s#%d  %s\n", formatIndicators(pr, nil), pr.RepoName, pr.Number, pr.Title))
+	b.WriteString("\n")
+	for _, l := range explainLines(pr, m.config) {
+		b.WriteString("  " + l + "\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(helpStyle.Render("Press any key to close"))
+	return b.String()
+}
+
+// formatAge formats an age column entry, in working time unless raw ages
+// were asked for.
+func (m model) formatAge(t time.Time) string {
+	if m.rawAge {
+		return formatAge(t)
+	}
+	return m.config.Calendar.formatAge(t)
+}
+
+// visibleItems returns the PRs the list shows, in list order, leaving out
+// collapsed groups.
 func (m model) visibleItems() []ClassifiedPR {
+	if m.groupBy == GroupNone {
+		return m.visibleIn(m.tab)
+	}
+	var vis []ClassifiedPR
+	for _, r := range m.rows() {
+		if r.header == "" {
+			vis = append(vis, r.pr)
+		}
+	}
+	return vis
+}
+
+// visibleIn returns the PRs tab i shows after its own filter and the
+// global ones.
+func (m model) visibleIn(i int) []ClassifiedPR {
+	t := m.tabs[i]
+	f := m.tabFilter
+	if i != m.tab {
+		f = m.tabStates[i].filter
+	}
+	src := m.items
+	if t.Mine {
+		src = m.authorItems
+	}
 	var vis []ClassifiedPR
-	for _, pr := range m.items {
-		if m.dismissed[pr.URL] || m.dismissedRepos[pr.RepoName] || m.dismissedAuthors[pr.Author] {
+	for _, pr := range src {
+		if !t.Match.matches(pr) {
+			continue
+		}
+		if m.dismissedRepos[pr.RepoName] || m.dismissals.hides(pr) {
 			continue
 		}
-		if m.focusRepo != "" && pr.RepoName != m.focusRepo {
+		if f.hideCovered && pr.TeamSatisfiedBy != "" && pr.MyReview == MyNone {
 			continue
 		}
-		if m.focusAuthor != "" && pr.Author != m.focusAuthor {
+		if f.awaitingOnly && pr.ThreadsAwaitingMe == 0 {
 			continue
 		}
-		if m.searchQuery != "" {
-			q := strings.ToLower(m.searchQuery)
+		if f.breachedOnly && pr.SLA != SLABreach {
+			continue
+		}
+		if f.unseenOnly && pr.Unseen == "" {
+			continue
+		}
+		if !t.Mine && !f.involvement.matches(pr) {
+			continue
+		}
+		if f.focusRepo != "" && pr.RepoName != f.focusRepo {
+			continue
+		}
+		if f.focusStack != "" && pr.StackRoot != f.focusStack {
+			continue
+		}
+		if f.focusAuthor != "" && pr.Author != f.focusAuthor {
+			continue
+		}
+		if f.searchQuery != "" {
+			q := strings.ToLower(f.searchQuery)
 			if !strings.Contains(strings.ToLower(pr.Title), q) &&
 				!strings.Contains(strings.ToLower(pr.RepoName), q) &&
 				!strings.Contains(strings.ToLower(pr.Author), q) {
@@ -739,12 +1493,136 @@ func (m model) visibleItems() []ClassifiedPR {
 	return vis
 }
 
+// selectURL moves the cursor to the PR with url, if it's visible.
+func (m *model) selectURL(url string) {
+	for i, r := range m.rows() {
+		if r.header == "" && r.pr.URL == url {
+			m.cursor = i
+			return
+		}
+	}
+}
+
+// markSelectedSeen records the selected PR as seen. Its "since last seen"
+// and "snooze ended" markers stay until the list is next rebuilt.
+func (m model) markSelectedSeen() {
+	if pr, ok := m.selectedPR(); ok {
+		m.seen.markSeen(pr)
+		m.dismissals.acknowledge(pr.URL)
+	}
+}
+
+// selectedPR returns the PR under the cursor; there's none on a group
+// header.
 func (m model) selectedPR() (ClassifiedPR, bool) {
-	vis := m.visibleItems()
-	if m.cursor < 0 || m.cursor >= len(vis) {
+	rows := m.rows()
+	if m.cursor < 0 || m.cursor >= len(rows) || rows[m.cursor].header != "" {
 		return ClassifiedPR{}, false
 	}
-	return vis[m.cursor], true
+	return rows[m.cursor].pr, true
+}
+
+// authorRow renders one of my PRs for the My PRs view: the indicators,
+// then what's blocking the merge and whose review I'm waiting on.
+func (m model) authorRow(pr ClassifiedPR, selected bool) string {
+	var bg *lipgloss.Style
+	if selected {
+		bg = &selBg
+	}
+	style := func(s lipgloss.Style) lipgloss.Style {
+		if pr.IsDraft {
+			s = styleDim
+		}
+		return withBg(s, bg)
+	}
+	namesStyle := func(names []string, s lipgloss.Style) lipgloss.Style {
+		if len(names) == 0 {
+			return style(styleDim)
+		}
+		return style(s)
+	}
+
+	w := m.authorCols
+	ageTime := pr.CreatedAt
+	if m.sortMode == SortDate {
+		ageTime = pr.LastActivity
+	}
+	repoCol := fmt.Sprintf("%-*s", w.repo, fmt.Sprintf("%s#%d", pr.RepoName, pr.Number))
+	ageCol := fmt.Sprintf("%4s", m.formatAge(ageTime))
+	readyCol := fmt.Sprintf("%-*s", w.ready, pr.Readiness)
+	pendingCol := fitNames(pr.PendingReviewers, w.pending)
+	changesCol := fitNames(pr.ChangesRequestedBy, w.changes)
+
+	title := m.markPrefix(pr) + stackPrefix(pr) + displayTitle(pr)
+	overhead := len("I O C S T   ") + w.repo + 2 + 4 + 2 + w.ready + 2 + w.pending + 2 + w.changes + 2
+	if m.width > 0 {
+		title = truncateWidth(title, m.width-overhead)
+	}
+
+	sep := "  "
+	space := " "
+	if bg != nil {
+		sep, space = bg.Render(sep), bg.Render(space)
+	}
+	line := formatIndicators(pr, bg) + space +
+		style(nameColor(pr.RepoName)).Render(repoCol) + sep +
+		style(styleDim).Render(ageCol) + sep +
+		style(readinessStyle(pr.Readiness)).Render(readyCol) + sep +
+		namesStyle(pr.PendingReviewers, styleWhite).Render(pendingCol) + sep +
+		namesStyle(pr.ChangesRequestedBy, styleRed).Render(changesCol) + sep +
+		style(lipgloss.NewStyle()).Render(title)
+	if selected && m.width > 0 {
+		if n := lipgloss.Width(line); n < m.width {
+			line += selBg.Render(strings.Repeat(" ", m.width-n))
+		}
+	}
+	return line
+}
+
+// readinessStyle colors the merge column: green when ready, red or orange
+// when I have to act, yellow while waiting on CI.
+func readinessStyle(r Readiness) lipgloss.Style {
+	switch r {
+	case ReadyToMerge:
+		return styleGreen
+	case ReadyConflict:
+		return styleOrange
+	case ReadyCIFailing, ReadyChanges:
+		return styleRed
+	case ReadyCIPending:
+		return styleYellow
+	case ReadyNeedsReview:
+		return styleWhite
+	default:
+		return styleDim
+	}
+}
+
+// sizeStyle colors the size column: small is green, large is yellow, extra
+// large is red.
+func sizeStyle(s PRSize) lipgloss.Style {
+	switch s {
+	case SizeXS, SizeS:
+		return styleGreen
+	case SizeL:
+		return styleYellow
+	case SizeXL:
+		return styleRed
+	default:
+		return styleWhite
+	}
+}
+
+// ageStyle colors the age column by review SLA state.
+func ageStyle(pr ClassifiedPR) lipgloss.Style {
+	switch pr.SLA {
+	case SLABreach:
+		return styleRed
+	case SLAWarn:
+		return styleYellow
+	default:
+		return styleDim
+	}
 }
 
 func withBg(s lipgloss.Style, bg *lipgloss.Style) lipgloss.Style {
@@ -763,18 +1641,26 @@ func formatIndicators(pr ClassifiedPR, bg *lipgloss.Style) string {
 		col2 = withBg(styleDim, bg).Render("·")
 		col3 = withBg(styleDim, bg).Render("·")
 		col4 = withBg(styleDim, bg).Render("·")
+		col5 := withBg(styleDim, bg).Render("·  ")
 		sep := " "
 		if bg != nil {
 			sep = bg.Render(" ")
 		}
-		return col1 + sep + col2 + sep + col3 + sep + col4
+		return col1 + sep + col2 + sep + col3 + sep + col4 + sep + col5
 	}
 
 	switch pr.MyReview {
 	case MyNone:
-		if pr.IsCodeOwner {
+		switch {
+		case pr.RequestedVia == RequestDirect:
+			col1 = withBg(styleMagenta, bg).Render("•")
+		case pr.TeamSatisfiedBy != "":
+			col1 = withBg(styleDim, bg).Render("·")
+		case pr.IsCodeOwner:
 			col1 = withBg(styleOrange, bg).Render("·")
-		} else {
+		case pr.RequestedVia == RequestTeam:
+			col1 = withBg(styleWhite, bg).Render("·")
+		default:
 			col1 = withBg(styleDim, bg).Render("·")
 		}
 	case MyApproved:
@@ -789,6 +1675,8 @@ func formatIndicators(pr ClassifiedPR, bg *lipgloss.Style) string {
 		col1 = withBg(styleDim, bg).Render("✗")
 	case MyCommentedStale:
 		col1 = withBg(styleDim, bg).Render("◆")
+	case MyPending:
+		col1 = withBg(styleOrange, bg).Render("✎")
 	default:
 		col1 = withBg(styleDim, bg).Render("·")
 	}
@@ -817,6 +1705,8 @@ func formatIndicators(pr ClassifiedPR, bg *lipgloss.Style) string {
 		col3 = withBg(styleDim, bg).Render("○")
 	case ActMineStale:
 		col3 = withBg(styleDim, bg).Render("●")
+	case ActMentioned:
+		col3 = withBg(styleMagenta, bg).Render("@")
 	default:
 		col3 = withBg(styleDim, bg).Render("·")
 	}
@@ -834,9 +1724,19 @@ func formatIndicators(pr ClassifiedPR, bg *lipgloss.Style) string {
 		col4 = withBg(styleDim, bg).Render("·")
 	}
 
+	var col5 string
+	switch {
+	case pr.ThreadsAwaitingMe > 0:
+		col5 = withBg(styleCyan, bg).Render(threadsCol(pr))
+	case pr.UnresolvedThreads > 0:
+		col5 = withBg(styleWhite, bg).Render(threadsCol(pr))
+	default:
+		col5 = withBg(styleDim, bg).Render(threadsCol(pr))
+	}
+
 	sep := " "
 	if bg != nil {
 		sep = bg.Render(" ")
 	}
-	return col1 + sep + col2 + sep + col3 + sep + col4
+	return col1 + sep + col2 + sep + col3 + sep + col4 + sep + col5
 }
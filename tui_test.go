@@ -2,11 +2,16 @@ package main
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 )
 
 func withURL(url string) func(*PRNode) {
@@ -30,7 +35,8 @@ func testModelConfig() modelConfig {
 		},
 		me:       "me",
 		myTeams:  make(map[string]bool),
-		showAssigned: false,
+		// A single tab shows every PR and hides the tab bar
+		config: Config{Tabs: []TabConfig{{Name: "All"}}},
 	}
 }
 
@@ -49,7 +55,7 @@ func TestModel_Init(t *testing.T) {
 	if m.cursor != 0 {
 		t.Fatalf("expected cursor at 0, got %d", m.cursor)
 	}
-	if len(m.dismissed) != 0 {
+	if len(m.dismissals.PRs) != 0 {
 		t.Fatalf("expected empty dismissed map")
 	}
 	// Should have 4 items (including self-authored)
@@ -94,7 +100,7 @@ func TestModel_Dismiss(t *testing.T) {
 	m := newModel(testModelConfig())
 	firstURL := m.items[0].URL
 	m = sendKey(m, 'd')
-	if !m.dismissed[firstURL] {
+	if m.dismissals.PRs[firstURL].At.IsZero() {
 		t.Fatal("expected first item to be dismissed by URL")
 	}
 	vis := m.visibleItems()
@@ -130,7 +136,7 @@ func TestModel_DismissRepo(t *testing.T) {
 
 	// Dismiss the repo of the selected (first) PR
 	m = sendKey(m, 'D')
-	if !m.dismissedRepos[firstRepo] {
+	if m.dismissals.Repos[firstRepo].IsZero() {
 		t.Fatalf("expected %s to be dismissed", firstRepo)
 	}
 	vis := m.visibleItems()
@@ -174,6 +180,26 @@ func TestModel_ViewContainsIndicators(t *testing.T) {
 	}
 }
 
+func TestModel_TruncatesWideTitles(t *testing.T) {
+	// Whatever byte the cut lands on, it must not split a rune
+	for _, lead := range []string{"", "a", "ab"} {
+		cfg := testModelConfig()
+		cfg.rawPRs[0].Title = lead + strings.Repeat("修正", 40)
+		m := sendMsg(newModel(cfg), tea.WindowSizeMsg{Width: 80, Height: 20})
+		for _, line := range strings.Split(m.View(), "\n") {
+			if !strings.Contains(line, "alice") {
+				continue
+			}
+			if !utf8.ValidString(line) || lipgloss.Width(line) > 80 || !strings.Contains(line, "…") {
+				t.Errorf("expected the title cut to fit 80 cells, got %d: %q", lipgloss.Width(line), line)
+			}
+		}
+	}
+	if got := truncateWidth("修正修正", 5); got != "修正…" {
+		t.Errorf("truncateWidth = %q, want %q", got, "修正…")
+	}
+}
+
 func TestModel_Quit(t *testing.T) {
 	m := newModel(testModelConfig())
 	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
@@ -201,19 +227,24 @@ func TestModel_ToggleAssigned(t *testing.T) {
 	}
 	m := newModel(cfg)
 
-	// Toggle assigned on — only the PR with review request for "me"
+	// First 'a' — only the PR with review request for "me"
 	m = sendKey(m, 'a')
-	if !m.showAssigned {
-		t.Fatal("expected showAssigned to be true")
+	if m.involvement != InvolveRequested {
+		t.Fatalf("expected involvement requested, got %q", m.involvement)
 	}
 	if len(m.visibleItems()) != 1 {
-		t.Fatalf("expected 1 item with assigned filter, got %d", len(m.visibleItems()))
+		t.Fatalf("expected 1 item with requested filter, got %d", len(m.visibleItems()))
 	}
 
-	// Toggle assigned off — all PRs again
-	m = sendKey(m, 'a')
+	// Cycle back round to all — all PRs again
+	for range len(involvementFilters) - 1 {
+		m = sendKey(m, 'a')
+	}
+	if m.involvement != InvolveAll {
+		t.Fatalf("expected involvement all after a full cycle, got %q", m.involvement)
+	}
 	if len(m.visibleItems()) != 4 {
-		t.Fatalf("expected 4 items after turning assigned off, got %d", len(m.visibleItems()))
+		t.Fatalf("expected 4 items after cycling back to all, got %d", len(m.visibleItems()))
 	}
 }
 
@@ -229,20 +260,20 @@ func TestModel_HelpBarShowsToggleState(t *testing.T) {
 	m = sendMsg(m, tea.WindowSizeMsg{Width: 120, Height: 20})
 
 	view := m.View()
-	if !strings.Contains(view, "assigned:off") {
-		t.Error("expected help bar to show assigned:off")
+	if !strings.Contains(view, "involves:all") {
+		t.Error("expected help bar to show involves:all")
 	}
 
 	m = sendKey(m, 'a')
 	view = m.View()
-	if !strings.Contains(view, "assigned:on") {
-		t.Error("expected help bar to show assigned:on after toggle")
+	if !strings.Contains(view, "involves:requested") {
+		t.Error("expected help bar to show involves:requested after 'a'")
 	}
 }
 
 func TestModel_EmptyFilterShowsMessage(t *testing.T) {
 	cfg := testModelConfig()
-	cfg.showAssigned = true // no review requests, so everything filtered out
+	cfg.involvement = InvolveRequested // no review requests, so everything filtered out
 	m := newModel(cfg)
 	m = sendMsg(m, tea.WindowSizeMsg{Width: 120, Height: 20})
 
@@ -259,17 +290,18 @@ func TestModel_DismissPersistsAcrossToggle(t *testing.T) {
 
 	// Dismiss the first item
 	m = sendKey(m, 'd')
-	if !m.dismissed[dismissedURL] {
+	if m.dismissals.PRs[dismissedURL].At.IsZero() {
 		t.Fatal("expected PR to be dismissed")
 	}
 	if len(m.visibleItems()) != initialCount-1 {
 		t.Fatal("expected one fewer visible item after dismiss")
 	}
 
-	// Toggle assigned on and off — dismissal should persist
-	m = sendKey(m, 'a')
-	m = sendKey(m, 'a')
-	if !m.dismissed[dismissedURL] {
+	// Cycle involvement all the way round — dismissal should persist
+	for range involvementFilters {
+		m = sendKey(m, 'a')
+	}
+	if m.dismissals.PRs[dismissedURL].At.IsZero() {
 		t.Fatal("expected dismissal to persist across toggle")
 	}
 	if len(m.visibleItems()) != initialCount-1 {
@@ -289,7 +321,7 @@ func TestModel_ToggleResetsCursor(t *testing.T) {
 	}
 }
 
-func TestModel_ToggleAssignedWithA(t *testing.T) {
+func TestModel_CycleInvolvementWithA(t *testing.T) {
 	cfg := testModelConfig()
 	cfg.rawPRs[0].ReviewRequests.Nodes = []ReviewRequestNode{
 		{RequestedReviewer: struct {
@@ -299,18 +331,28 @@ func TestModel_ToggleAssignedWithA(t *testing.T) {
 	}
 	m := newModel(cfg)
 
-	// 'a' toggles assigned filter
-	m = sendKey(m, 'a')
-	if !m.showAssigned {
-		t.Fatal("expected showAssigned true after 'a'")
-	}
-	if len(m.visibleItems()) != 1 {
-		t.Fatalf("expected 1 item with assigned filter, got %d", len(m.visibleItems()))
-	}
-
-	m = sendKey(m, 'a')
-	if m.showAssigned {
-		t.Fatal("expected showAssigned false after second 'a'")
+	// alice requested my review, I commented on bob's, reviewed carol's
+	// and wrote the fourth
+	want := []struct {
+		filter InvolvementFilter
+		count  int
+	}{
+		{InvolveRequested, 1},
+		{InvolveAny, 4},
+		{InvolveAssignee, 0},
+		{InvolveMentioned, 0},
+		{InvolveParticipant, 2},
+		{InvolveAuthor, 1},
+		{InvolveAll, 4},
+	}
+	for _, w := range want {
+		m = sendKey(m, 'a')
+		if m.involvement != w.filter {
+			t.Fatalf("expected involvement %q, got %q", w.filter, m.involvement)
+		}
+		if got := len(m.visibleItems()); got != w.count {
+			t.Errorf("%s: expected %d items, got %d", w.filter, w.count, got)
+		}
 	}
 }
 
@@ -365,20 +407,53 @@ func TestModel_FocusAuthor(t *testing.T) {
 	}
 }
 
+func TestModel_ResetClearsEveryFilter(t *testing.T) {
+	m := newModel(testModelConfig())
+	m = sendKey(m, 'a')
+	m = sendKey(m, 't')
+	m = sendKey(m, 'w')
+	m = sendKey(m, 'b')
+	m = sendKey(m, 'n')
+	m = sendKey(m, 'F')
+	m = sendKey(m, 'R')
+	if m.tabFilter != (tabFilter{}) {
+		t.Errorf("expected every filter reset, got %+v", m.tabFilter)
+	}
+	if len(m.visibleItems()) != 4 {
+		t.Errorf("expected all 4 PRs back, got %d", len(m.visibleItems()))
+	}
+}
+
 func TestModel_UndoDismissals(t *testing.T) {
 	m := newModel(testModelConfig())
 	m = sendKey(m, 'd') // dismiss first PR
-	if len(m.dismissed) != 1 {
+	if len(m.dismissals.PRs) != 1 {
 		t.Fatal("expected 1 dismissal")
 	}
 
 	m = sendKey(m, 'R') // reset all filters
-	if len(m.dismissed) != 0 {
-		t.Fatal("expected dismissals cleared")
+	if len(m.dismissals.PRs) != 1 {
+		t.Fatal("expected R to keep dismissals")
 	}
 	if m.statusMsg != "Reset all filters" {
 		t.Errorf("expected status message, got %q", m.statusMsg)
 	}
+
+	// Only the X screen clears them, after asking
+	m = sendKey(m, 'X')
+	m = sendKey(m, 'C')
+	m = sendKey(m, 'j')
+	if len(m.dismissals.PRs) != 1 || m.statusMsg != "Cancelled" {
+		t.Fatalf("expected any other key to cancel, got %q", m.statusMsg)
+	}
+	m = sendKey(m, 'C')
+	if m.statusMsg != "Restore all 1? Press C to confirm" {
+		t.Fatalf("expected a confirmation, got %q", m.statusMsg)
+	}
+	m = sendKey(m, 'C')
+	if len(m.dismissals.PRs) != 0 || m.statusMsg != "Restored 1 dismissed or snoozed" {
+		t.Errorf("expected dismissals cleared, got %q", m.statusMsg)
+	}
 }
 
 func TestModel_LoadingState(t *testing.T) {
@@ -550,8 +625,8 @@ func TestModel_ClaudeCommentConfirmation(t *testing.T) {
 
 	// First c — should ask for confirmation
 	m = sendKey(m, 'c')
-	if !m.confirmingComment {
-		t.Fatal("expected confirmingComment=true after first c")
+	if m.confirmingComment == nil {
+		t.Fatal("expected a pending comment after first c")
 	}
 	if !strings.Contains(m.statusMsg, "Press c to confirm") {
 		t.Errorf("expected confirmation prompt, got %q", m.statusMsg)
@@ -560,8 +635,8 @@ func TestModel_ClaudeCommentConfirmation(t *testing.T) {
 	// Second c — should post comment
 	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
 	m2 := updated.(model)
-	if m2.confirmingComment {
-		t.Fatal("expected confirmingComment=false after confirm")
+	if m2.confirmingComment != nil {
+		t.Fatal("expected no pending comment after confirm")
 	}
 	if !strings.Contains(m2.statusMsg, "Commenting on") {
 		t.Errorf("expected commenting status, got %q", m2.statusMsg)
@@ -576,14 +651,14 @@ func TestModel_ClaudeCommentCancel(t *testing.T) {
 
 	// First c — confirm prompt
 	m = sendKey(m, 'c')
-	if !m.confirmingComment {
-		t.Fatal("expected confirmingComment=true")
+	if m.confirmingComment == nil {
+		t.Fatal("expected a pending comment")
 	}
 
 	// Press something else — should cancel
 	m = sendKey(m, 'j')
-	if m.confirmingComment {
-		t.Fatal("expected confirmingComment=false after cancel")
+	if m.confirmingComment != nil {
+		t.Fatal("expected no pending comment after cancel")
 	}
 	if m.statusMsg != "Cancelled" {
 		t.Errorf("expected Cancelled status, got %q", m.statusMsg)
@@ -751,3 +826,835 @@ func TestModel_Search(t *testing.T) {
 		t.Fatal("expected all items visible after clearing search")
 	}
 }
+
+func TestModel_ToggleHideCovered(t *testing.T) {
+	at := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	cfg := testModelConfig()
+	cfg.myTeams = map[string]bool{"backend-team": true}
+	cfg.teamMembers = map[string][]string{"backend-team": {"me", "bob"}}
+	cfg.rawPRs = append(cfg.rawPRs, makePR(
+		withAuthor("dave"),
+		withReviewRequest("", "backend-team", false),
+		withReview("bob", "APPROVED", at),
+		withURL("https://github.com/org/repo/pull/5"),
+	))
+	m := newModel(cfg)
+	if len(m.visibleItems()) != 5 {
+		t.Fatalf("expected 5 items initially, got %d", len(m.visibleItems()))
+	}
+
+	m = sendKey(m, 't')
+	if !m.hideCovered {
+		t.Fatal("expected hideCovered=true after t")
+	}
+	for _, pr := range m.visibleItems() {
+		if pr.Author == "dave" {
+			t.Fatal("expected covered team request to be hidden")
+		}
+	}
+
+	m = sendKey(m, 't')
+	if len(m.visibleItems()) != 5 {
+		t.Fatalf("expected 5 items after toggling back, got %d", len(m.visibleItems()))
+	}
+}
+
+func TestModel_ToggleAwaitingReply(t *testing.T) {
+	cfg := testModelConfig()
+	cfg.rawPRs = append(cfg.rawPRs, makePR(
+		withAuthor("dave"),
+		withThread(false, "me", "dave"),
+		withURL("https://github.com/org/repo/pull/5"),
+	))
+	m := newModel(cfg)
+	m = sendKey(m, 'w')
+	if !m.awaitingOnly {
+		t.Fatal("expected awaitingOnly=true after w")
+	}
+	vis := m.visibleItems()
+	if len(vis) != 1 || vis[0].Author != "dave" {
+		t.Fatalf("expected only dave's PR, got %d items", len(vis))
+	}
+	m = sendKey(m, 'w')
+	if len(m.visibleItems()) != 5 {
+		t.Fatalf("expected 5 items after toggling back, got %d", len(m.visibleItems()))
+	}
+}
+
+func TestModel_BreachedOnlyFromFlag(t *testing.T) {
+	cfg := testModelConfig()
+	cfg.config.SLA = mustSLA(t, SLAConfig{SLAThresholds: SLAThresholds{Breach: "2d"}})
+	cfg.rawPRs = append(cfg.rawPRs, makePR(
+		withAuthor("dave"),
+		withReviewRequest("me", "", false),
+		withURL("https://github.com/org/repo/pull/5"),
+	))
+	cfg.breachedOnly = true
+	m := newModel(cfg)
+	vis := m.visibleItems()
+	if len(vis) != 1 || vis[0].Author != "dave" {
+		t.Fatalf("expected only dave's breached PR, got %d items", len(vis))
+	}
+	m = sendKey(m, 'b')
+	if m.breachedOnly || len(m.visibleItems()) != 5 {
+		t.Fatalf("expected 5 items after toggling off, got %d", len(m.visibleItems()))
+	}
+}
+
+func TestModel_ExplainOverlay(t *testing.T) {
+	m := newModel(testModelConfig())
+	m = sendMsg(m, tea.WindowSizeMsg{Width: 120, Height: 30})
+
+	m = sendKey(m, 'x')
+	if !m.showExplain {
+		t.Fatal("expected showExplain=true after x")
+	}
+	view := m.View()
+	if !strings.Contains(view, "I (yours):") || !strings.Contains(view, "sort:") {
+		t.Errorf("expected explanation in view, got %q", view)
+	}
+
+	m = sendKey(m, 'j')
+	if m.showExplain {
+		t.Fatal("expected any key to close the explanation")
+	}
+	if m.cursor != 0 {
+		t.Fatal("expected the closing key not to move the cursor")
+	}
+}
+
+func TestModel_CycleSort(t *testing.T) {
+	m := newModel(testModelConfig())
+	var got []SortMode
+	for range sortModes {
+		m = sendKey(m, 's')
+		got = append(got, m.sortMode)
+	}
+	want := []SortMode{SortDate, SortSmall, SortQuickWins, SortPriority}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("sort cycle = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestModel_FocusStack(t *testing.T) {
+	cfg := testModelConfig()
+	cfg.rawPRs[0].HeadRefName = "part-1"
+	cfg.rawPRs[1].BaseRefName = "part-1"
+	m := newModel(cfg)
+	m.cursor = -1
+	for i, pr := range m.visibleItems() {
+		if pr.Author == "alice" {
+			m.cursor = i
+		}
+	}
+	if pr, _ := m.selectedPR(); pr.StackRoot == "" {
+		t.Fatal("expected alice's PR to start a stack")
+	}
+
+	m = sendKey(m, 'f')
+	if m.focusStack == "" {
+		t.Fatal("expected focusStack to be set")
+	}
+	if vis := m.visibleItems(); len(vis) != 2 {
+		t.Fatalf("expected the 2 stacked PRs visible, got %d", len(vis))
+	}
+
+	// f again moves to repo focus, then clears
+	m = sendKey(m, 'f')
+	if m.focusStack != "" || m.focusRepo == "" {
+		t.Fatalf("expected repo focus, got stack %q repo %q", m.focusStack, m.focusRepo)
+	}
+	m = sendKey(m, 'f')
+	if m.focusRepo != "" {
+		t.Fatal("expected focus cleared on third press")
+	}
+}
+
+func TestModel_UnseenFilterAndMarkSeen(t *testing.T) {
+	cfg := testModelConfig()
+	seen, _ := loadSeen("")
+	seen.since = time.Time{} // every PR opened since tracking started
+	seen.entries["https://github.com/org/repo/pull/1"] = seenEntry{At: time.Now()}
+	seen.entries["https://github.com/org/repo/pull/2"] = seenEntry{At: time.Now()}
+	cfg.rawPRs[1].Comments.TotalCount = 1
+	cfg.seen = seen
+	m := newModel(cfg)
+
+	m = sendKey(m, 'n')
+	vis := m.visibleItems()
+	if len(vis) != 3 {
+		t.Fatalf("expected bob (new comment) plus the two never-seen PRs, got %d", len(vis))
+	}
+	for _, pr := range vis {
+		if pr.Author == "bob" && pr.Unseen != "1 comment" {
+			t.Errorf("bob: unseen = %q, want %q", pr.Unseen, "1 comment")
+		}
+	}
+
+	// Moving onto a PR marks it seen; the marker clears once the list is rebuilt
+	m = sendKey(m, 'j')
+	selected, _ := m.selectedPR()
+	m.reclassify()
+	for _, pr := range m.visibleItems() {
+		if pr.URL == selected.URL {
+			t.Errorf("expected %s to drop out of the unseen filter after viewing", pr.Author)
+		}
+	}
+}
+
+func TestModel_Notifications(t *testing.T) {
+	cfg := testModelConfig()
+	m := newModel(cfg)
+	vis := m.visibleItems()
+	url := vis[0].URL
+	m.notifications = map[string]Notification{url: {ThreadID: "7", Unread: true}}
+	m.reclassify()
+	if pr, _ := m.selectedPR(); !pr.Unread || !strings.HasPrefix(displayTitle(pr), "✉ ") {
+		t.Fatalf("expected selected PR unread with a ✉ mark, got %+v", pr)
+	}
+
+	m = sendMsg(m, notificationDoneMsg{url: url})
+	if pr, _ := m.selectedPR(); pr.Unread || pr.NotificationThread != "7" {
+		t.Errorf("expected PR read but thread kept, got unread=%v thread=%q", pr.Unread, pr.NotificationThread)
+	}
+
+	// m on a PR without a thread explains instead of calling the API
+	m.cursor = 1
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'m'}})
+	if cmd != nil || !strings.Contains(updated.(model).statusMsg, "No notification thread") {
+		t.Errorf("expected no-thread status, got %q", updated.(model).statusMsg)
+	}
+}
+
+func TestModel_InterdiffNeedsStaleReview(t *testing.T) {
+	m := newModel(testModelConfig())
+	for i, pr := range m.visibleItems() {
+		if !isStaleReview(pr.MyReview) {
+			m.cursor = i
+			break
+		}
+	}
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}})
+	if cmd != nil || updated.(model).statusMsg != "No new commits since your review" {
+		t.Errorf("expected no-op with status, got cmd=%v status=%q", cmd != nil, updated.(model).statusMsg)
+	}
+}
+
+func TestModel_MyPRsView(t *testing.T) {
+	cfg := testModelConfig()
+	cfg.rawPRs[3].ReviewRequests.Nodes = []ReviewRequestNode{
+		{RequestedReviewer: struct {
+			Login string `json:"login"`
+			Slug  string `json:"slug"`
+		}{Login: "bob"}},
+	}
+	cfg.config.Tabs = nil // default tabs
+	m := newModel(cfg)
+	m = sendMsg(m, tea.WindowSizeMsg{Width: 160, Height: 20})
+
+	m = sendKey(m, '2')
+	if !m.mine() {
+		t.Fatal("expected 2 to switch to My PRs")
+	}
+	vis := m.visibleItems()
+	if len(vis) != 1 || vis[0].Author != "me" {
+		t.Fatalf("expected only my PR, got %d items", len(vis))
+	}
+	if vis[0].Readiness != ReadyNeedsReview {
+		t.Errorf("expected needs review, got %q", vis[0].Readiness)
+	}
+	view := m.View()
+	for _, want := range []string{"waiting on", "changes by", "needs review", "bob", "2 My PRs (1)"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected My PRs view to contain %q", want)
+		}
+	}
+
+	// The involvement filter doesn't hide my PRs
+	m = sendKey(m, 'a')
+	if len(m.visibleItems()) != 1 {
+		t.Errorf("expected involvement filter to leave My PRs alone, got %d items", len(m.visibleItems()))
+	}
+
+	m = sendMsg(m, tea.KeyMsg{Type: tea.KeyTab})
+	if m.mine() {
+		t.Fatal("expected tab to move on to the Team tab")
+	}
+}
+
+func TestModel_Tabs(t *testing.T) {
+	cfg := testModelConfig()
+	cfg.rawPRs[0].ReviewRequests.Nodes = []ReviewRequestNode{
+		{RequestedReviewer: struct {
+			Login string `json:"login"`
+			Slug  string `json:"slug"`
+		}{Login: "me"}},
+	}
+	cfg.config.Tabs = nil // default tabs
+	m := newModel(cfg)
+	m = sendMsg(m, tea.WindowSizeMsg{Width: 160, Height: 20})
+
+	view := m.View()
+	for _, want := range []string{"1 Needs review (1)", "2 My PRs (1)", "3 Team (0)", "4 All (4)"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected tab bar to contain %q", want)
+		}
+	}
+	if len(m.visibleItems()) != 1 {
+		t.Fatalf("expected Needs review to show 1 PR, got %d", len(m.visibleItems()))
+	}
+
+	// Each tab keeps its own cursor and sort order
+	m = sendKey(m, '4')
+	m = sendKey(m, 'j')
+	m = sendKey(m, 's')
+	if m.cursor != 0 || m.sortMode != SortDate {
+		t.Fatalf("expected All tab at cursor 0 sorted by date, got %d %s", m.cursor, m.sortMode)
+	}
+	m = sendKey(m, 'j')
+	m = sendKey(m, '1')
+	if m.sortMode != SortPriority || m.cursor != 0 {
+		t.Errorf("expected Needs review to keep priority sort, got %s cursor %d", m.sortMode, m.cursor)
+	}
+	m = sendMsg(m, tea.KeyMsg{Type: tea.KeyShiftTab})
+	if m.tab != 3 || m.sortMode != SortDate || m.cursor != 1 {
+		t.Errorf("expected shift+tab back to All at date sort, cursor 1; got tab %d %s cursor %d", m.tab, m.sortMode, m.cursor)
+	}
+	if len(m.visibleItems()) != 4 {
+		t.Errorf("expected All to show 4 PRs, got %d", len(m.visibleItems()))
+	}
+
+	// ...and its own filters: focusing an author on All leaves Needs review alone
+	m = sendKey(m, 'F')
+	if len(m.visibleItems()) != 1 || !strings.Contains(m.View(), "4 All (1)") {
+		t.Fatalf("expected All focused on one author, got %d", len(m.visibleItems()))
+	}
+	m = sendKey(m, '1')
+	if m.focusAuthor != "" || len(m.visibleItems()) != 1 || !strings.Contains(m.View(), "4 All (1)") {
+		t.Errorf("expected Needs review unfocused and All still counted with its focus, got %q", m.focusAuthor)
+	}
+	m = sendKey(m, '4')
+	if m.focusAuthor == "" {
+		t.Error("expected All to get its focus back")
+	}
+}
+
+func TestModel_StartInMyPRs(t *testing.T) {
+	cfg := testModelConfig()
+	cfg.config.Tabs = []TabConfig{{Name: "Review"}, {Name: "Mine", Mine: true}}
+	cfg.startInMyPRs = true
+	m := newModel(cfg)
+	if m.tab != 1 || !m.mine() {
+		t.Errorf("expected to start on the Mine tab, got tab %d", m.tab)
+	}
+}
+
+func TestModel_DetailPane(t *testing.T) {
+	m := newModel(testModelConfig())
+	m = sendMsg(m, tea.WindowSizeMsg{Width: 120, Height: 30})
+	pr := m.visibleItems()[0]
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}})
+	m = updated.(model)
+	if !m.showDetail || cmd == nil {
+		t.Fatal("expected p to open the pane and fetch its detail")
+	}
+	if !strings.Contains(m.View(), "Loading") {
+		t.Error("expected a loading message before the detail arrives")
+	}
+
+	m = sendMsg(m, detailMsg{url: pr.URL, detail: PRDetail{
+		Body:      "Adds retries",
+		Reviewers: []ReviewerState{{"bob", "approved"}},
+		Checks:    []CheckResult{{"build", "failure"}},
+	}})
+	view := m.View()
+	for _, want := range []string{"Reviewers", "bob", "approved", "build", "failure", "Adds retries", "Timeline"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected detail pane to contain %q", want)
+		}
+	}
+
+	// Other keys don't leak through to the list
+	m = sendKey(m, 'd')
+	if len(m.dismissals.PRs) != 0 {
+		t.Error("expected d to be ignored in the detail pane")
+	}
+
+	// J moves to the next PR and fetches it; closing and reopening the
+	// first uses the cache
+	m = sendKey(m, 'J')
+	if m.cursor != 1 || m.details[m.visibleItems()[1].URL] == nil {
+		t.Fatal("expected J to move to and fetch the next PR")
+	}
+	m = sendMsg(m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.showDetail {
+		t.Fatal("expected esc to close the pane")
+	}
+	m = sendKey(m, 'k')
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}})
+	m = updated.(model)
+	if !m.showDetail || cmd != nil {
+		t.Error("expected a cached detail to open without fetching")
+	}
+}
+
+func TestModel_SubmitReview(t *testing.T) {
+	m := newModel(testModelConfig())
+	i := slices.IndexFunc(m.visibleItems(), func(pr ClassifiedPR) bool { return pr.Author == "alice" })
+	m.cursor = i
+	pr := m.visibleItems()[i]
+
+	m = sendKey(m, 'e')
+	if !m.choosingReview {
+		t.Fatal("expected e to ask for the kind of review")
+	}
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	m = updated.(model)
+	if m.choosingReview || cmd == nil {
+		t.Fatal("expected a to open the editor")
+	}
+
+	// An empty change request is cancelled
+	m = sendMsg(m, reviewEditedMsg{draft: reviewDraft{pr: pr, event: ReviewRequestChanges}})
+	if m.confirmingReview != nil {
+		t.Fatal("expected empty change request to be cancelled")
+	}
+
+	m = sendMsg(m, reviewEditedMsg{draft: reviewDraft{pr: pr, event: ReviewApprove, body: "LGTM"}})
+	if m.confirmingReview == nil || !strings.Contains(m.statusMsg, "Press e to confirm") {
+		t.Fatalf("expected a confirmation prompt, got %q", m.statusMsg)
+	}
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	m = updated.(model)
+	if m.confirmingReview != nil || cmd == nil {
+		t.Fatal("expected second e to submit")
+	}
+
+	m = sendMsg(m, reviewSubmittedMsg{draft: reviewDraft{pr: pr, event: ReviewApprove, body: "LGTM"}})
+	got := m.visibleItems()[m.cursor]
+	if got.URL != pr.URL || got.MyReview != MyApproved {
+		t.Errorf("expected %s to stay selected and show approved, got %s %s", pr.URL, got.URL, got.MyReview)
+	}
+}
+
+func TestModel_ReviewCancelledAndOwnPR(t *testing.T) {
+	m := newModel(testModelConfig())
+	m = sendKey(m, 'e')
+	m = sendKey(m, 'x')
+	if m.choosingReview || m.statusMsg != "Cancelled" {
+		t.Errorf("expected other key to cancel, got %q", m.statusMsg)
+	}
+
+	m.cursor = slices.IndexFunc(m.visibleItems(), func(pr ClassifiedPR) bool { return pr.IsAuthor })
+	m = sendKey(m, 'e')
+	if m.choosingReview {
+		t.Error("expected no review of my own PR")
+	}
+}
+
+func TestModel_CommentTemplates(t *testing.T) {
+	cfg := testModelConfig()
+	cfg.config.Templates = []CommentTemplate{
+		{Name: "ping", Body: "Friendly ping @{{.Author}}"},
+		{Name: "rebase", Body: "Please rebase {{.RepoName}}#{{.Number}}", Edit: true},
+	}
+	m := newModel(cfg)
+	m = sendMsg(m, tea.WindowSizeMsg{Width: 200, Height: 20})
+	pr := m.visibleItems()[0]
+	if !strings.Contains(m.View(), "c: comment") {
+		t.Error("expected help bar to show 'c: comment' with several templates")
+	}
+
+	m = sendKey(m, 'c')
+	if !m.choosingTemplate || !strings.Contains(m.statusMsg, "1 ping, 2 rebase") {
+		t.Fatalf("expected template picker, got %q", m.statusMsg)
+	}
+	m = sendKey(m, '1')
+	if m.confirmingComment == nil || m.confirmingComment.body != "Friendly ping @"+pr.Author {
+		t.Fatalf("expected rendered ping awaiting confirmation, got %+v", m.confirmingComment)
+	}
+	if !strings.Contains(m.statusMsg, "Press c to confirm") {
+		t.Errorf("expected confirmation prompt, got %q", m.statusMsg)
+	}
+	m = sendKey(m, 'j')
+	if m.confirmingComment != nil || m.statusMsg != "Cancelled" {
+		t.Fatal("expected other key to cancel")
+	}
+
+	// A template with edit opens the editor, then confirms the edited text
+	m.cursor = 0
+	m = sendKey(m, 'c')
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'2'}})
+	m = updated.(model)
+	if cmd == nil || m.confirmingComment != nil {
+		t.Fatal("expected the editor to open before confirming")
+	}
+	m = sendMsg(m, commentEditedMsg{draft: commentDraft{pr: pr, name: "rebase", body: "Please rebase, thanks!"}})
+	if m.confirmingComment == nil || m.confirmingComment.body != "Please rebase, thanks!" {
+		t.Fatalf("expected edited comment awaiting confirmation, got %+v", m.confirmingComment)
+	}
+	m = sendKey(m, 'j')
+	m = sendMsg(m, commentEditedMsg{draft: commentDraft{pr: pr, name: "rebase"}})
+	if m.statusMsg != "Cancelled: empty comment" {
+		t.Errorf("expected empty edit to cancel, got %q", m.statusMsg)
+	}
+
+	m = sendKey(m, 'c')
+	m = sendKey(m, 'x')
+	if m.choosingTemplate || m.statusMsg != "Cancelled" {
+		t.Errorf("expected non-number to cancel the picker, got %q", m.statusMsg)
+	}
+}
+
+func TestModel_BotRequestWarning(t *testing.T) {
+	m := newModel(testModelConfig())
+	m = sendMsg(m, tea.WindowSizeMsg{Width: 200, Height: 20})
+	if strings.Contains(m.View(), "[bot review") {
+		t.Fatal("expected no bot marker before anyone asked")
+	}
+
+	// Posting the request records it straight away
+	pr := m.visibleItems()[0]
+	m = sendMsg(m, commentPostedMsg{repo: pr.RepoFullName, number: pr.Number, body: "@claude please review this PR"})
+	if !strings.Contains(m.View(), "[bot review requested]") {
+		t.Error("expected bot marker after posting the request")
+	}
+	sel, _ := m.selectedPR()
+	if sel.BotReview != BotRequested || sel.BotRequestedBy != "me" {
+		t.Fatalf("expected cursor on the requested PR, got %+v", sel)
+	}
+
+	m = sendKey(m, 'c')
+	if !strings.Contains(m.statusMsg, "⚠ @claude already asked by me just now, bot review requested") {
+		t.Errorf("expected duplicate request warning, got %q", m.statusMsg)
+	}
+}
+
+func TestModel_MarkAndBulkDismiss(t *testing.T) {
+	m := newModel(testModelConfig())
+	m = sendMsg(m, tea.WindowSizeMsg{Width: 200, Height: 20})
+	total := len(m.visibleItems())
+
+	m = sendKey(m, ' ')
+	if len(m.markedItems()) != 1 || m.cursor != 1 {
+		t.Fatalf("expected one mark and cursor moved down, got %d marks, cursor %d", len(m.markedItems()), m.cursor)
+	}
+	if !strings.Contains(m.View(), "● ") || !strings.Contains(m.View(), "mark:1") {
+		t.Error("expected marked row and count in the help bar")
+	}
+	m = sendKey(m, 'k')
+	m = sendKey(m, ' ')
+	if len(m.markedItems()) != 0 {
+		t.Fatal("expected space to unmark")
+	}
+
+	// V marks a range
+	m = sendKey(m, 'V')
+	m = sendKey(m, 'j')
+	m = sendKey(m, 'j')
+	m = sendKey(m, 'V')
+	if len(m.markedItems()) != 3 || m.rangeFrom != -1 {
+		t.Fatalf("expected 3 marked by range, got %d", len(m.markedItems()))
+	}
+
+	m = sendKey(m, 'd')
+	if m.confirmingBulk == nil || !strings.Contains(m.statusMsg, "Dismiss 3 PRs? Press d to confirm") {
+		t.Fatalf("expected bulk confirmation, got %q", m.statusMsg)
+	}
+	m = sendKey(m, 'd')
+	if got := len(m.visibleItems()); got != total-3 {
+		t.Errorf("expected 3 PRs dismissed, %d of %d left", got, total)
+	}
+	if m.statusMsg != "Dismissed 3 PRs" || len(m.marked) != 0 {
+		t.Errorf("expected summary and marks cleared, got %q", m.statusMsg)
+	}
+}
+
+func TestModel_MarkAllAndCancel(t *testing.T) {
+	m := newModel(testModelConfig())
+	m = sendMsg(m, tea.KeyMsg{Type: tea.KeyCtrlA})
+	if len(m.markedItems()) != len(m.visibleItems()) {
+		t.Fatal("expected ctrl+a to mark every visible PR")
+	}
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(model)
+	if m.confirmingBulk == nil || !strings.Contains(m.statusMsg, "Open 4 PRs in the browser") {
+		t.Fatalf("expected open confirmation, got %q", m.statusMsg)
+	}
+	m = sendKey(m, 'j')
+	if m.confirmingBulk != nil || m.statusMsg != "Cancelled" || len(m.markedItems()) != 4 {
+		t.Error("expected cancel to keep the marks")
+	}
+	m = sendMsg(m, tea.KeyMsg{Type: tea.KeyCtrlA})
+	if len(m.markedItems()) != 0 {
+		t.Error("expected second ctrl+a to unmark all")
+	}
+	m = sendKey(m, ' ')
+	m = sendMsg(m, tea.KeyMsg{Type: tea.KeyEsc})
+	if len(m.marked) != 0 || m.statusMsg != "Cleared marks" {
+		t.Error("expected esc to clear marks")
+	}
+}
+
+func TestModel_BulkCommentAndLabel(t *testing.T) {
+	m := newModel(testModelConfig())
+	m = sendKey(m, ' ')
+	m = sendKey(m, ' ')
+	m = sendKey(m, 'c')
+	if m.confirmingBulk == nil || m.confirmingBulk.kind != bulkComment || len(m.confirmingBulk.bodies) != 2 {
+		t.Fatalf("expected bulk comment awaiting confirmation, got %+v", m.confirmingBulk)
+	}
+	if !strings.Contains(m.statusMsg, "Post @claude on 2 PRs? Press c to confirm") {
+		t.Errorf("unexpected prompt %q", m.statusMsg)
+	}
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	m = updated.(model)
+	if cmd == nil || len(m.marked) != 0 {
+		t.Fatal("expected the comments to be posted in the background")
+	}
+	a := bulkAction{kind: bulkComment, prs: m.visibleItems()[:2], bodies: []string{"@claude please review this PR", "@claude please review this PR"}, name: "@claude"}
+	m = sendMsg(m, bulkDoneMsg{action: a, done: []int{0}, failed: []bulkFailure{{a.prs[1], fmt.Errorf("forbidden")}}})
+	if !strings.Contains(m.statusMsg, "Posted @claude on 1 of 2 PRs; failed:") {
+		t.Errorf("unexpected summary %q", m.statusMsg)
+	}
+
+	// L labels the selected PR when nothing is marked
+	m.cursor = 0
+	url := m.visibleItems()[0].URL
+	m = sendKey(m, 'L')
+	if !m.labeling {
+		t.Fatal("expected label prompt")
+	}
+	for _, r := range "deps" {
+		m = sendKey(m, r)
+	}
+	if !strings.Contains(m.View(), "Label 1 PR: deps") {
+		t.Error("expected label prompt with the count")
+	}
+	m = sendMsg(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.labeling || m.statusMsg != `Label 1 PR "deps"? Press L to confirm` {
+		t.Fatalf("expected enter to ask before labelling, got %q", m.statusMsg)
+	}
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'L'}})
+	m = updated.(model)
+	if cmd == nil {
+		t.Fatal("expected L again to add the label")
+	}
+	m = sendMsg(m, bulkDoneMsg{action: bulkAction{kind: bulkLabel, prs: m.visibleItems()[:1], name: "deps"}, done: []int{0}})
+	for _, pr := range m.items {
+		if pr.URL == url && !slices.Contains(pr.Labels, "deps") {
+			t.Errorf("expected the label recorded, got %v", pr.Labels)
+		}
+	}
+	if m.statusMsg != `Added label "deps" to 1 PR` {
+		t.Errorf("unexpected summary %q", m.statusMsg)
+	}
+}
+
+func TestModel_GroupBy(t *testing.T) {
+	cfg := testModelConfig()
+	cfg.rawPRs[1].Repository.Name = "web"
+	cfg.groupBy = GroupRepo
+	m := newModel(cfg)
+	m = sendMsg(m, tea.WindowSizeMsg{Width: 200, Height: 20})
+	view := m.View()
+	if !strings.Contains(view, "▾ repo (3)") || !strings.Contains(view, "▾ web (1)") || !strings.Contains(view, "g: group:repo") {
+		t.Fatalf("expected group headers with counts:\n%s", view)
+	}
+	if _, ok := m.selectedPR(); ok {
+		t.Fatal("expected the cursor to start on a header")
+	}
+
+	// Collapse the first group from one of its PRs
+	m = sendKey(m, 'j')
+	if _, ok := m.selectedPR(); !ok {
+		t.Fatal("expected a PR under the header")
+	}
+	m = sendKey(m, 'z')
+	if !strings.Contains(m.View(), "▸ ") || m.cursor != 0 {
+		t.Fatalf("expected a collapsed group with the cursor on its header, cursor %d", m.cursor)
+	}
+	if got := len(m.visibleItems()); got != 1 {
+		t.Errorf("expected only the expanded group's PR visible, got %d", got)
+	}
+	// Navigation skips the collapsed group's PRs
+	m = sendKey(m, 'j')
+	m = sendKey(m, 'j')
+	if pr, ok := m.selectedPR(); !ok || pr.RepoName != "web" {
+		t.Errorf("expected to land on the web PR, got %+v", pr)
+	}
+	m = sendKey(m, 'j')
+	if pr, _ := m.selectedPR(); pr.RepoName != "web" {
+		t.Error("expected the cursor to stop at the last row")
+	}
+
+	m = sendKey(m, 'Z')
+	if len(m.visibleItems()) != 0 || m.statusMsg != "Collapsed all groups" {
+		t.Errorf("expected every group collapsed, got %q", m.statusMsg)
+	}
+	m = sendKey(m, 'Z')
+	if len(m.visibleItems()) != 4 {
+		t.Errorf("expected every group expanded, got %d PRs", len(m.visibleItems()))
+	}
+
+	for range groupModes[2:] {
+		m = sendKey(m, 'g')
+	}
+	m = sendKey(m, 'g')
+	if m.groupBy != GroupNone || strings.Contains(m.View(), "▾") {
+		t.Errorf("expected g to cycle back to no grouping, got %q", m.groupBy)
+	}
+}
+
+func TestModel_DismissUntilActivity(t *testing.T) {
+	cfg := testModelConfig()
+	m := newModel(cfg)
+	pr, _ := m.selectedPR()
+	m = sendKey(m, 'u')
+	if !strings.Contains(m.statusMsg, "until new commits or comments") || len(m.visibleItems()) != 3 {
+		t.Fatalf("expected PR dismissed until activity, got %q", m.statusMsg)
+	}
+
+	// A refresh with a new comment brings it back
+	raw := slices.Clone(cfg.rawPRs)
+	for i := range raw {
+		if raw[i].URL == pr.URL {
+			withCommentAt("dave", time.Now())(&raw[i])
+			raw[i].Comments.TotalCount++
+		}
+	}
+	m = sendMsg(m, fetchPageMsg{prs: raw, me: "me", myTeams: map[string]bool{}, done: true})
+	if len(m.visibleItems()) != 4 || len(m.dismissals.PRs) != 0 {
+		t.Errorf("expected the PR back after new activity, %d visible", len(m.visibleItems()))
+	}
+	if m.statusMsg != "1 PR back after their dismissal ended" {
+		t.Errorf("unexpected status %q", m.statusMsg)
+	}
+}
+
+func TestModel_DismissalsScreen(t *testing.T) {
+	m := newModel(testModelConfig())
+	m = sendKey(m, 'd')
+	m = sendKey(m, 'A')
+	m = sendKey(m, 'X')
+	view := m.View()
+	if !m.showDismissals || !strings.Contains(view, "author") || !strings.Contains(view, "PR") {
+		t.Fatalf("expected dismissals listed:\n%s", view)
+	}
+	before := len(m.visibleItems())
+	m = sendKey(m, 'x') // restore the author
+	if len(m.dismissals.Authors) != 0 || !strings.HasPrefix(m.statusMsg, "Restored author") {
+		t.Errorf("expected author restored, got %q", m.statusMsg)
+	}
+	m = sendKey(m, 'd') // then the PR
+	if len(m.dismissals.PRs) != 0 || !strings.Contains(m.View(), "Nothing dismissed") {
+		t.Error("expected the PR restored too")
+	}
+	m = sendMsg(m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.showDismissals || len(m.visibleItems()) <= before {
+		t.Error("expected the screen closed and PRs back")
+	}
+}
+
+func TestModel_DismissalsScreenUnlabelled(t *testing.T) {
+	m := newModel(testModelConfig())
+	url := "https://github.com/org/repo/pull/9"
+	m.dismissals.PRs[url] = prDismissal{At: time.Now()} // no ref or title
+	m = sendKey(m, 'X')
+	if !strings.Contains(m.View(), url) {
+		t.Errorf("expected the URL shown for an unlabelled dismissal:\n%s", m.View())
+	}
+	m = sendKey(m, 'd')
+	if len(m.dismissals.PRs) != 0 || m.statusMsg != "Restored PR "+url {
+		t.Errorf("expected the PR restored, got %q", m.statusMsg)
+	}
+}
+
+func TestModel_SavesStateOnChange(t *testing.T) {
+	m := newModel(testModelConfig())
+	path := filepath.Join(t.TempDir(), "dismissed.json")
+	m.dismissals.path = path
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	m = updated.(model)
+	if cmd == nil || !m.saveScheduled {
+		t.Fatal("expected a save scheduled after dismissing")
+	}
+	m = sendKey(m, 'd')
+	if _, err := os.Stat(path); err == nil {
+		t.Fatal("expected the save to wait")
+	}
+	m = sendMsg(m, saveStateMsg{})
+	loaded, err := loadDismissals(path)
+	if err != nil || len(loaded.PRs) != 2 {
+		t.Fatalf("expected both dismissals saved, got %+v (%v)", loaded.PRs, err)
+	}
+	if m.saveScheduled || m.dismissals.unsaved() {
+		t.Error("expected nothing left to save")
+	}
+}
+
+func TestModel_Snooze(t *testing.T) {
+	m := newModel(testModelConfig())
+	pr, _ := m.selectedPR()
+	m = sendKey(m, 'S')
+	if !m.choosingSnooze || !strings.Contains(m.statusMsg, "3 tomorrow 9am") {
+		t.Fatalf("expected snooze choices, got %q", m.statusMsg)
+	}
+	m = sendKey(m, '3')
+	if !strings.HasPrefix(m.statusMsg, "Snoozed repo#1 until ") || len(m.visibleItems()) != 3 {
+		t.Fatalf("expected PR snoozed, got %q", m.statusMsg)
+	}
+
+	// The minute check brings it back once the snooze ends
+	m = sendMsg(m, snoozeTickMsg(time.Now()))
+	if len(m.visibleItems()) != 3 {
+		t.Fatal("expected PR still snoozed")
+	}
+	m = sendMsg(m, snoozeTickMsg(time.Now().Add(72*time.Hour)))
+	if len(m.visibleItems()) != 4 || m.statusMsg != "1 PR back: snooze ended" {
+		t.Fatalf("expected PR back, got %q", m.statusMsg)
+	}
+	if !strings.Contains(m.View(), "[snooze ended]") {
+		t.Error("expected the snooze-ended marker")
+	}
+	m.selectURL(pr.URL)
+	m = sendKey(m, 'j')
+	m = sendKey(m, 'k')
+	if _, ok := m.dismissals.Woke[pr.URL]; ok {
+		t.Error("expected marker acknowledged once selected")
+	}
+}
+
+func TestModel_SnoozeCustomDate(t *testing.T) {
+	m := newModel(testModelConfig())
+	m = sendKey(m, 'S')
+	m = sendKey(m, '5')
+	if !m.snoozeTyping {
+		t.Fatal("expected custom snooze input")
+	}
+	for _, r := range "yesterday" {
+		m = sendKey(m, r)
+	}
+	m = sendMsg(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if !strings.HasPrefix(m.statusMsg, "invalid snooze time") || len(m.dismissals.Snoozes) != 0 {
+		t.Fatalf("expected invalid time rejected, got %q", m.statusMsg)
+	}
+
+	m = sendKey(m, 'S')
+	m = sendKey(m, '5')
+	for _, r := range time.Now().AddDate(0, 0, 3).Format("2006-01-02") {
+		m = sendKey(m, r)
+	}
+	m = sendMsg(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if len(m.dismissals.Snoozes) != 1 || !strings.Contains(m.View(), "Snoozed") {
+		t.Errorf("expected PR snoozed, got %q", m.statusMsg)
+	}
+	m = sendKey(m, 'X')
+	if !strings.Contains(m.View(), "snooze") {
+		t.Error("expected snooze on the dismissals screen")
+	}
+}